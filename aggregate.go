@@ -0,0 +1,552 @@
+package pointer
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrOverflow is returned by the checked helpers when an integer result
+// does not fit its type.
+var ErrOverflow = errors.New("pointer: integer overflow")
+
+// Count returns the number of non-nil pointers in s.
+func Count[T any](s []*T) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// CountMap returns the number of non-nil values in m.
+func CountMap[K comparable, T any](m map[K]*T) int {
+	n := 0
+	for _, val := range m {
+		if val != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Sum returns the sum of the values the pointers of s point to, skipping
+// nil pointers. Integer sums wrap around on overflow; see SumChecked.
+func Sum[T Number](s []*T) T {
+	var sum T
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil {
+			sum += *(s[i])
+		}
+	}
+	return sum
+}
+
+// SumMap returns the sum of the values of m, skipping nil values.
+func SumMap[K comparable, T Number](m map[K]*T) T {
+	var sum T
+	for _, val := range m {
+		if val != nil {
+			sum += *val
+		}
+	}
+	return sum
+}
+
+// SumChecked is like Sum but returns ErrOverflow if the sum does not fit
+// in T. Only the total counts: intermediate sums that overflow are not
+// reported if the later values bring the sum back in range.
+func SumChecked[T Integer](s []*T) (T, error) {
+	return sumChecked(func(add func(T)) {
+		for i := 0; i < len(s); i++ {
+			if s[i] != nil {
+				add(*(s[i]))
+			}
+		}
+	})
+}
+
+// SumMapChecked is like SumMap but returns ErrOverflow if the sum does
+// not fit in T. As for SumChecked only the total counts, so the result
+// does not depend on the iteration order of m.
+func SumMapChecked[K comparable, T Integer](m map[K]*T) (T, error) {
+	return sumChecked(func(add func(T)) {
+		for _, val := range m {
+			if val != nil {
+				add(*val)
+			}
+		}
+	})
+}
+
+// sumChecked sums the values passed to add by each and returns
+// ErrOverflow if the total does not fit in T. Signed values are
+// accumulated in 128 bits so that the order of the values does not
+// matter; unsigned partial sums only grow, so any overflow is final.
+func sumChecked[T Integer](each func(add func(T))) (T, error) {
+	if !isSigned[T]() {
+		var sum T
+		ok := true
+		each(func(v T) {
+			var vok bool
+			sum, vok = addChecked(sum, v)
+			ok = ok && vok
+		})
+		if !ok {
+			return 0, ErrOverflow
+		}
+		return sum, nil
+	}
+	var hi, lo uint64
+	each(func(v T) {
+		var carry uint64
+		lo, carry = bits.Add64(lo, uint64(int64(v)), 0)
+		hi += uint64(int64(v)>>63) + carry
+	})
+	sum := int64(lo)
+	if hi != uint64(sum>>63) || int64(T(sum)) != sum {
+		return 0, ErrOverflow
+	}
+	return T(sum), nil
+}
+
+// Mean returns the arithmetic mean of the values the pointers of s point
+// to, skipping nil pointers. It reports false if s holds no non-nil
+// pointer.
+func Mean[T Number](s []*T) (float64, bool) {
+	var sum float64
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil {
+			sum += float64(*(s[i]))
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
+
+// MeanMap returns the arithmetic mean of the values of m, skipping nil
+// values. It reports false if m holds no non-nil value.
+func MeanMap[K comparable, T Number](m map[K]*T) (float64, bool) {
+	var sum float64
+	n := 0
+	for _, val := range m {
+		if val != nil {
+			sum += float64(*val)
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
+
+// addChecked returns a+b and reports whether the sum did not overflow.
+func addChecked[T Integer](a, b T) (T, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return sum, false
+	}
+	return sum, true
+}
+
+// IntSum returns the sum of the int values of src, skipping nil
+// pointers.
+func IntSum(src []*int) int {
+	return Sum(src)
+}
+
+// IntSumMap returns the sum of the int values of src, skipping nil values.
+func IntSumMap(src map[string]*int) int {
+	return SumMap(src)
+}
+
+// IntSumChecked returns the sum of the int values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in int.
+func IntSumChecked(src []*int) (int, error) {
+	return SumChecked(src)
+}
+
+// IntSumMapChecked returns the sum of the int values of src, skipping
+// nil values, or ErrOverflow if it does not fit in int.
+func IntSumMapChecked(src map[string]*int) (int, error) {
+	return SumMapChecked(src)
+}
+
+// IntMean returns the mean of the int values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func IntMean(src []*int) (float64, bool) {
+	return Mean(src)
+}
+
+// IntMeanMap returns the mean of the int values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func IntMeanMap(src map[string]*int) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Int8Sum returns the sum of the int8 values of src, skipping nil
+// pointers.
+func Int8Sum(src []*int8) int8 {
+	return Sum(src)
+}
+
+// Int8SumMap returns the sum of the int8 values of src, skipping nil values.
+func Int8SumMap(src map[string]*int8) int8 {
+	return SumMap(src)
+}
+
+// Int8SumChecked returns the sum of the int8 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in int8.
+func Int8SumChecked(src []*int8) (int8, error) {
+	return SumChecked(src)
+}
+
+// Int8SumMapChecked returns the sum of the int8 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in int8.
+func Int8SumMapChecked(src map[string]*int8) (int8, error) {
+	return SumMapChecked(src)
+}
+
+// Int8Mean returns the mean of the int8 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Int8Mean(src []*int8) (float64, bool) {
+	return Mean(src)
+}
+
+// Int8MeanMap returns the mean of the int8 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Int8MeanMap(src map[string]*int8) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Int16Sum returns the sum of the int16 values of src, skipping nil
+// pointers.
+func Int16Sum(src []*int16) int16 {
+	return Sum(src)
+}
+
+// Int16SumMap returns the sum of the int16 values of src, skipping nil values.
+func Int16SumMap(src map[string]*int16) int16 {
+	return SumMap(src)
+}
+
+// Int16SumChecked returns the sum of the int16 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in int16.
+func Int16SumChecked(src []*int16) (int16, error) {
+	return SumChecked(src)
+}
+
+// Int16SumMapChecked returns the sum of the int16 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in int16.
+func Int16SumMapChecked(src map[string]*int16) (int16, error) {
+	return SumMapChecked(src)
+}
+
+// Int16Mean returns the mean of the int16 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Int16Mean(src []*int16) (float64, bool) {
+	return Mean(src)
+}
+
+// Int16MeanMap returns the mean of the int16 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Int16MeanMap(src map[string]*int16) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Int32Sum returns the sum of the int32 values of src, skipping nil
+// pointers.
+func Int32Sum(src []*int32) int32 {
+	return Sum(src)
+}
+
+// Int32SumMap returns the sum of the int32 values of src, skipping nil values.
+func Int32SumMap(src map[string]*int32) int32 {
+	return SumMap(src)
+}
+
+// Int32SumChecked returns the sum of the int32 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in int32.
+func Int32SumChecked(src []*int32) (int32, error) {
+	return SumChecked(src)
+}
+
+// Int32SumMapChecked returns the sum of the int32 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in int32.
+func Int32SumMapChecked(src map[string]*int32) (int32, error) {
+	return SumMapChecked(src)
+}
+
+// Int32Mean returns the mean of the int32 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Int32Mean(src []*int32) (float64, bool) {
+	return Mean(src)
+}
+
+// Int32MeanMap returns the mean of the int32 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Int32MeanMap(src map[string]*int32) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Int64Sum returns the sum of the int64 values of src, skipping nil
+// pointers.
+func Int64Sum(src []*int64) int64 {
+	return Sum(src)
+}
+
+// Int64SumMap returns the sum of the int64 values of src, skipping nil values.
+func Int64SumMap(src map[string]*int64) int64 {
+	return SumMap(src)
+}
+
+// Int64SumChecked returns the sum of the int64 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in int64.
+func Int64SumChecked(src []*int64) (int64, error) {
+	return SumChecked(src)
+}
+
+// Int64SumMapChecked returns the sum of the int64 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in int64.
+func Int64SumMapChecked(src map[string]*int64) (int64, error) {
+	return SumMapChecked(src)
+}
+
+// Int64Mean returns the mean of the int64 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Int64Mean(src []*int64) (float64, bool) {
+	return Mean(src)
+}
+
+// Int64MeanMap returns the mean of the int64 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Int64MeanMap(src map[string]*int64) (float64, bool) {
+	return MeanMap(src)
+}
+
+// UintSum returns the sum of the uint values of src, skipping nil
+// pointers.
+func UintSum(src []*uint) uint {
+	return Sum(src)
+}
+
+// UintSumMap returns the sum of the uint values of src, skipping nil values.
+func UintSumMap(src map[string]*uint) uint {
+	return SumMap(src)
+}
+
+// UintSumChecked returns the sum of the uint values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in uint.
+func UintSumChecked(src []*uint) (uint, error) {
+	return SumChecked(src)
+}
+
+// UintSumMapChecked returns the sum of the uint values of src, skipping
+// nil values, or ErrOverflow if it does not fit in uint.
+func UintSumMapChecked(src map[string]*uint) (uint, error) {
+	return SumMapChecked(src)
+}
+
+// UintMean returns the mean of the uint values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func UintMean(src []*uint) (float64, bool) {
+	return Mean(src)
+}
+
+// UintMeanMap returns the mean of the uint values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func UintMeanMap(src map[string]*uint) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Uint8Sum returns the sum of the uint8 values of src, skipping nil
+// pointers.
+func Uint8Sum(src []*uint8) uint8 {
+	return Sum(src)
+}
+
+// Uint8SumMap returns the sum of the uint8 values of src, skipping nil values.
+func Uint8SumMap(src map[string]*uint8) uint8 {
+	return SumMap(src)
+}
+
+// Uint8SumChecked returns the sum of the uint8 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in uint8.
+func Uint8SumChecked(src []*uint8) (uint8, error) {
+	return SumChecked(src)
+}
+
+// Uint8SumMapChecked returns the sum of the uint8 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in uint8.
+func Uint8SumMapChecked(src map[string]*uint8) (uint8, error) {
+	return SumMapChecked(src)
+}
+
+// Uint8Mean returns the mean of the uint8 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Uint8Mean(src []*uint8) (float64, bool) {
+	return Mean(src)
+}
+
+// Uint8MeanMap returns the mean of the uint8 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Uint8MeanMap(src map[string]*uint8) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Uint16Sum returns the sum of the uint16 values of src, skipping nil
+// pointers.
+func Uint16Sum(src []*uint16) uint16 {
+	return Sum(src)
+}
+
+// Uint16SumMap returns the sum of the uint16 values of src, skipping nil values.
+func Uint16SumMap(src map[string]*uint16) uint16 {
+	return SumMap(src)
+}
+
+// Uint16SumChecked returns the sum of the uint16 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in uint16.
+func Uint16SumChecked(src []*uint16) (uint16, error) {
+	return SumChecked(src)
+}
+
+// Uint16SumMapChecked returns the sum of the uint16 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in uint16.
+func Uint16SumMapChecked(src map[string]*uint16) (uint16, error) {
+	return SumMapChecked(src)
+}
+
+// Uint16Mean returns the mean of the uint16 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Uint16Mean(src []*uint16) (float64, bool) {
+	return Mean(src)
+}
+
+// Uint16MeanMap returns the mean of the uint16 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Uint16MeanMap(src map[string]*uint16) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Uint32Sum returns the sum of the uint32 values of src, skipping nil
+// pointers.
+func Uint32Sum(src []*uint32) uint32 {
+	return Sum(src)
+}
+
+// Uint32SumMap returns the sum of the uint32 values of src, skipping nil values.
+func Uint32SumMap(src map[string]*uint32) uint32 {
+	return SumMap(src)
+}
+
+// Uint32SumChecked returns the sum of the uint32 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in uint32.
+func Uint32SumChecked(src []*uint32) (uint32, error) {
+	return SumChecked(src)
+}
+
+// Uint32SumMapChecked returns the sum of the uint32 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in uint32.
+func Uint32SumMapChecked(src map[string]*uint32) (uint32, error) {
+	return SumMapChecked(src)
+}
+
+// Uint32Mean returns the mean of the uint32 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Uint32Mean(src []*uint32) (float64, bool) {
+	return Mean(src)
+}
+
+// Uint32MeanMap returns the mean of the uint32 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Uint32MeanMap(src map[string]*uint32) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Uint64Sum returns the sum of the uint64 values of src, skipping nil
+// pointers.
+func Uint64Sum(src []*uint64) uint64 {
+	return Sum(src)
+}
+
+// Uint64SumMap returns the sum of the uint64 values of src, skipping nil values.
+func Uint64SumMap(src map[string]*uint64) uint64 {
+	return SumMap(src)
+}
+
+// Uint64SumChecked returns the sum of the uint64 values of src, skipping nil
+// pointers, or ErrOverflow if it does not fit in uint64.
+func Uint64SumChecked(src []*uint64) (uint64, error) {
+	return SumChecked(src)
+}
+
+// Uint64SumMapChecked returns the sum of the uint64 values of src, skipping
+// nil values, or ErrOverflow if it does not fit in uint64.
+func Uint64SumMapChecked(src map[string]*uint64) (uint64, error) {
+	return SumMapChecked(src)
+}
+
+// Uint64Mean returns the mean of the uint64 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Uint64Mean(src []*uint64) (float64, bool) {
+	return Mean(src)
+}
+
+// Uint64MeanMap returns the mean of the uint64 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Uint64MeanMap(src map[string]*uint64) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Float32Sum returns the sum of the float32 values of src, skipping nil
+// pointers.
+func Float32Sum(src []*float32) float32 {
+	return Sum(src)
+}
+
+// Float32SumMap returns the sum of the float32 values of src, skipping nil values.
+func Float32SumMap(src map[string]*float32) float32 {
+	return SumMap(src)
+}
+
+// Float32Mean returns the mean of the float32 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Float32Mean(src []*float32) (float64, bool) {
+	return Mean(src)
+}
+
+// Float32MeanMap returns the mean of the float32 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Float32MeanMap(src map[string]*float32) (float64, bool) {
+	return MeanMap(src)
+}
+
+// Float64Sum returns the sum of the float64 values of src, skipping nil
+// pointers.
+func Float64Sum(src []*float64) float64 {
+	return Sum(src)
+}
+
+// Float64SumMap returns the sum of the float64 values of src, skipping nil values.
+func Float64SumMap(src map[string]*float64) float64 {
+	return SumMap(src)
+}
+
+// Float64Mean returns the mean of the float64 values of src, skipping nil
+// pointers. It reports false if src holds no non-nil pointer.
+func Float64Mean(src []*float64) (float64, bool) {
+	return Mean(src)
+}
+
+// Float64MeanMap returns the mean of the float64 values of src, skipping nil
+// values. It reports false if src holds no non-nil value.
+func Float64MeanMap(src map[string]*float64) (float64, bool) {
+	return MeanMap(src)
+}
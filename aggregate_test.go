@@ -0,0 +1,105 @@
+package pointer
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	if e, a := 2, Count([]*string{StringP("a"), nil, StringP("")}); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := 1, CountMap(map[string]*bool{"a": FalseP(), "b": nil}); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestSum(t *testing.T) {
+	s := []*int64{Int64P(1), nil, Int64P(2)}
+	if e, a := int64(3), Int64Sum(s); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	m := map[string]*float64{"a": Float64P(0.5), "b": nil, "c": Float64P(0.25)}
+	if e, a := 0.75, Float64SumMap(m); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := uint8(0), Uint8Sum(nil); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+var testCasesSumChecked = []struct {
+	in  []*int8
+	out int8
+	err error
+}{
+	{in: []*int8{Int8P(100), nil, Int8P(27)}, out: 127},
+	{in: []*int8{Int8P(100), Int8P(28)}, err: ErrOverflow},
+	{in: []*int8{Int8P(-100), Int8P(-28)}, out: -128},
+	{in: []*int8{Int8P(-100), Int8P(-29)}, err: ErrOverflow},
+	{in: []*int8{Int8P(127), Int8P(1), Int8P(-1)}, out: 127},
+	{in: []*int8{Int8P(-128), Int8P(-1), Int8P(1)}, out: -128},
+	{in: []*int8{Int8P(127), Int8P(127), Int8P(-128), Int8P(-128)}, out: -2},
+	{in: []*int8{Int8P(127), Int8P(127), Int8P(-126)}, err: ErrOverflow},
+}
+
+func TestSumChecked(t *testing.T) {
+	for idx, c := range testCasesSumChecked {
+		out, err := Int8SumChecked(c.in)
+		if !errors.Is(err, c.err) {
+			t.Errorf("Unexpected error at idx %d: %v", idx, err)
+		}
+		if e, a := c.out, out; e != a {
+			t.Errorf("Unexpected value at idx %d: expected %v, got %v", idx, e, a)
+		}
+	}
+	if _, err := Uint64SumChecked([]*uint64{Uint64P(math.MaxUint64), Uint64P(1)}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if _, err := Uint32SumMapChecked(map[string]*uint32{"a": Uint32P(math.MaxUint32), "b": Uint32P(1)}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if sum, err := IntSumMapChecked(map[string]*int{"a": IntP(1), "b": nil}); err != nil || sum != 1 {
+		t.Errorf("Expected 1, got %v, %v", sum, err)
+	}
+	if _, err := Int64SumChecked([]*int64{Int64P(math.MaxInt64), Int64P(math.MaxInt64), Int64P(math.MaxInt64)}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+}
+
+func TestSumMapCheckedOrder(t *testing.T) {
+	m := map[string]*int64{"a": Int64P(math.MaxInt64), "b": Int64P(1), "c": Int64P(-1), "d": nil}
+	over := map[string]*int64{"a": Int64P(math.MaxInt64), "b": Int64P(1), "c": Int64P(-1), "d": Int64P(1)}
+	// Map iteration order is random, so repeat to cover the orders in
+	// which a partial sum overflows.
+	for i := 0; i < 100; i++ {
+		if sum, err := Int64SumMapChecked(m); err != nil || sum != math.MaxInt64 {
+			t.Fatalf("Expected %d, got %v, %v", int64(math.MaxInt64), sum, err)
+		}
+		if _, err := Int64SumMapChecked(over); !errors.Is(err, ErrOverflow) {
+			t.Fatalf("Expected overflow, got %v", err)
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	mean, ok := Int32Mean([]*int32{Int32P(1), nil, Int32P(2)})
+	if !ok || mean != 1.5 {
+		t.Errorf("Expected 1.5, got %v, %v", mean, ok)
+	}
+	if _, ok := Float32Mean([]*float32{nil}); ok {
+		t.Errorf("Expected no mean for nil values")
+	}
+	mean, ok = Uint16MeanMap(map[string]*uint16{"a": Uint16P(4), "b": nil})
+	if !ok || mean != 4 {
+		t.Errorf("Expected 4, got %v, %v", mean, ok)
+	}
+	if _, ok := Int64MeanMap(nil); ok {
+		t.Errorf("Expected no mean for empty map")
+	}
+	mean, _ = Int64Mean([]*int64{Int64P(math.MaxInt64), Int64P(math.MaxInt64)})
+	if e := float64(math.MaxInt64); mean != e {
+		t.Errorf("Expected %v, got %v", e, mean)
+	}
+}
@@ -0,0 +1,911 @@
+package pointer
+
+import "errors"
+
+// ErrDivisionByZero is returned by Div and DivChecked for a zero divisor.
+var ErrDivisionByZero = errors.New("pointer: division by zero")
+
+// The arithmetic functions below return nil if any operand is nil. Wrap
+// the operands with OrZero to treat nil as zero instead, e.g.
+// Add(OrZero(a), OrZero(b)). They never modify their operands.
+
+// OrZero returns p, or a pointer to the zero value of T if p is nil.
+func OrZero[T any](p *T) *T {
+	if p == nil {
+		return new(T)
+	}
+	return p
+}
+
+// Add returns a pointer to a+b, or nil if a or b is nil.
+func Add[T Number](a, b *T) *T {
+	if a == nil || b == nil {
+		return nil
+	}
+	v := *a + *b
+	return &v
+}
+
+// Sub returns a pointer to a-b, or nil if a or b is nil.
+func Sub[T Number](a, b *T) *T {
+	if a == nil || b == nil {
+		return nil
+	}
+	v := *a - *b
+	return &v
+}
+
+// Mul returns a pointer to a*b, or nil if a or b is nil.
+func Mul[T Number](a, b *T) *T {
+	if a == nil || b == nil {
+		return nil
+	}
+	v := *a * *b
+	return &v
+}
+
+// Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Div[T Number](a, b *T) (*T, error) {
+	if a == nil || b == nil {
+		return nil, nil
+	}
+	if *b == 0 {
+		return nil, ErrDivisionByZero
+	}
+	v := *a / *b
+	return &v, nil
+}
+
+// Neg returns a pointer to -a, or nil if a is nil.
+func Neg[T Number](a *T) *T {
+	if a == nil {
+		return nil
+	}
+	v := -*a
+	return &v
+}
+
+// Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Abs[T Number](a *T) *T {
+	if a == nil {
+		return nil
+	}
+	v := *a
+	if v < 0 {
+		v = -v
+	}
+	return &v
+}
+
+// AddChecked is like Add but returns ErrOverflow if a+b does not fit in T.
+func AddChecked[T Integer](a, b *T) (*T, error) {
+	return checked(a, b, addChecked[T])
+}
+
+// SubChecked is like Sub but returns ErrOverflow if a-b does not fit in T.
+func SubChecked[T Integer](a, b *T) (*T, error) {
+	return checked(a, b, subChecked[T])
+}
+
+// MulChecked is like Mul but returns ErrOverflow if a*b does not fit in T.
+func MulChecked[T Integer](a, b *T) (*T, error) {
+	return checked(a, b, mulChecked[T])
+}
+
+// DivChecked is like Div but returns ErrOverflow if a/b does not fit in T,
+// which only happens for the minimum value of a signed type divided by -1.
+func DivChecked[T Integer](a, b *T) (*T, error) {
+	if a != nil && b != nil && *b == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return checked(a, b, divChecked[T])
+}
+
+// NegChecked is like Neg but returns ErrOverflow if -a does not fit in T,
+// which is the case for the minimum value of a signed type and for any
+// non-zero value of an unsigned type.
+func NegChecked[T Integer](a *T) (*T, error) {
+	return checked(a, a, func(a, _ T) (T, bool) { return negChecked(a) })
+}
+
+// AbsChecked is like Abs but returns ErrOverflow if the absolute value of
+// a does not fit in T.
+func AbsChecked[T Integer](a *T) (*T, error) {
+	return checked(a, a, func(a, _ T) (T, bool) {
+		if a < 0 {
+			return negChecked(a)
+		}
+		return a, true
+	})
+}
+
+func checked[T Integer](a, b *T, op func(a, b T) (T, bool)) (*T, error) {
+	if a == nil || b == nil {
+		return nil, nil
+	}
+	v, ok := op(*a, *b)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return &v, nil
+}
+
+// isSigned reports whether T is a signed integer type.
+func isSigned[T Integer]() bool {
+	return ^T(0) < 0
+}
+
+// subChecked returns a-b and reports whether the difference did not
+// overflow.
+func subChecked[T Integer](a, b T) (T, bool) {
+	diff := a - b
+	if (b > 0 && diff > a) || (b < 0 && diff < a) {
+		return diff, false
+	}
+	return diff, true
+}
+
+// mulChecked returns a*b and reports whether the product did not
+// overflow.
+func mulChecked[T Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if isSigned[T]() && b == ^T(0) {
+		return negChecked(a)
+	}
+	prod := a * b
+	return prod, prod/b == a
+}
+
+// divChecked returns a/b for a non-zero b and reports whether the quotient
+// did not overflow.
+func divChecked[T Integer](a, b T) (T, bool) {
+	if isSigned[T]() && b == ^T(0) {
+		return negChecked(a)
+	}
+	return a / b, true
+}
+
+// negChecked returns -a and reports whether the negation did not
+// overflow.
+func negChecked[T Integer](a T) (T, bool) {
+	neg := -a
+	if a != 0 && (!isSigned[T]() || neg == a) {
+		return neg, false
+	}
+	return neg, true
+}
+
+// IntAdd returns a pointer to a+b, or nil if a or b is nil.
+func IntAdd(a, b *int) *int {
+	return Add(a, b)
+}
+
+// IntSub returns a pointer to a-b, or nil if a or b is nil.
+func IntSub(a, b *int) *int {
+	return Sub(a, b)
+}
+
+// IntMul returns a pointer to a*b, or nil if a or b is nil.
+func IntMul(a, b *int) *int {
+	return Mul(a, b)
+}
+
+// IntDiv returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func IntDiv(a, b *int) (*int, error) {
+	return Div(a, b)
+}
+
+// IntNeg returns a pointer to -a, or nil if a is nil.
+func IntNeg(a *int) *int {
+	return Neg(a)
+}
+
+// IntAbs returns a pointer to the absolute value of a, or nil if a is nil.
+func IntAbs(a *int) *int {
+	return Abs(a)
+}
+
+// IntAddChecked is like IntAdd but returns ErrOverflow if a+b does not
+// fit in int.
+func IntAddChecked(a, b *int) (*int, error) {
+	return AddChecked(a, b)
+}
+
+// IntSubChecked is like IntSub but returns ErrOverflow if a-b does not
+// fit in int.
+func IntSubChecked(a, b *int) (*int, error) {
+	return SubChecked(a, b)
+}
+
+// IntMulChecked is like IntMul but returns ErrOverflow if a*b does not
+// fit in int.
+func IntMulChecked(a, b *int) (*int, error) {
+	return MulChecked(a, b)
+}
+
+// IntDivChecked is like IntDiv but returns ErrOverflow if a/b does not
+// fit in int.
+func IntDivChecked(a, b *int) (*int, error) {
+	return DivChecked(a, b)
+}
+
+// IntNegChecked is like IntNeg but returns ErrOverflow if -a does not
+// fit in int.
+func IntNegChecked(a *int) (*int, error) {
+	return NegChecked(a)
+}
+
+// IntAbsChecked is like IntAbs but returns ErrOverflow if the absolute
+// value of a does not fit in int.
+func IntAbsChecked(a *int) (*int, error) {
+	return AbsChecked(a)
+}
+
+// Int8Add returns a pointer to a+b, or nil if a or b is nil.
+func Int8Add(a, b *int8) *int8 {
+	return Add(a, b)
+}
+
+// Int8Sub returns a pointer to a-b, or nil if a or b is nil.
+func Int8Sub(a, b *int8) *int8 {
+	return Sub(a, b)
+}
+
+// Int8Mul returns a pointer to a*b, or nil if a or b is nil.
+func Int8Mul(a, b *int8) *int8 {
+	return Mul(a, b)
+}
+
+// Int8Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Int8Div(a, b *int8) (*int8, error) {
+	return Div(a, b)
+}
+
+// Int8Neg returns a pointer to -a, or nil if a is nil.
+func Int8Neg(a *int8) *int8 {
+	return Neg(a)
+}
+
+// Int8Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Int8Abs(a *int8) *int8 {
+	return Abs(a)
+}
+
+// Int8AddChecked is like Int8Add but returns ErrOverflow if a+b does not
+// fit in int8.
+func Int8AddChecked(a, b *int8) (*int8, error) {
+	return AddChecked(a, b)
+}
+
+// Int8SubChecked is like Int8Sub but returns ErrOverflow if a-b does not
+// fit in int8.
+func Int8SubChecked(a, b *int8) (*int8, error) {
+	return SubChecked(a, b)
+}
+
+// Int8MulChecked is like Int8Mul but returns ErrOverflow if a*b does not
+// fit in int8.
+func Int8MulChecked(a, b *int8) (*int8, error) {
+	return MulChecked(a, b)
+}
+
+// Int8DivChecked is like Int8Div but returns ErrOverflow if a/b does not
+// fit in int8.
+func Int8DivChecked(a, b *int8) (*int8, error) {
+	return DivChecked(a, b)
+}
+
+// Int8NegChecked is like Int8Neg but returns ErrOverflow if -a does not
+// fit in int8.
+func Int8NegChecked(a *int8) (*int8, error) {
+	return NegChecked(a)
+}
+
+// Int8AbsChecked is like Int8Abs but returns ErrOverflow if the absolute
+// value of a does not fit in int8.
+func Int8AbsChecked(a *int8) (*int8, error) {
+	return AbsChecked(a)
+}
+
+// Int16Add returns a pointer to a+b, or nil if a or b is nil.
+func Int16Add(a, b *int16) *int16 {
+	return Add(a, b)
+}
+
+// Int16Sub returns a pointer to a-b, or nil if a or b is nil.
+func Int16Sub(a, b *int16) *int16 {
+	return Sub(a, b)
+}
+
+// Int16Mul returns a pointer to a*b, or nil if a or b is nil.
+func Int16Mul(a, b *int16) *int16 {
+	return Mul(a, b)
+}
+
+// Int16Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Int16Div(a, b *int16) (*int16, error) {
+	return Div(a, b)
+}
+
+// Int16Neg returns a pointer to -a, or nil if a is nil.
+func Int16Neg(a *int16) *int16 {
+	return Neg(a)
+}
+
+// Int16Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Int16Abs(a *int16) *int16 {
+	return Abs(a)
+}
+
+// Int16AddChecked is like Int16Add but returns ErrOverflow if a+b does not
+// fit in int16.
+func Int16AddChecked(a, b *int16) (*int16, error) {
+	return AddChecked(a, b)
+}
+
+// Int16SubChecked is like Int16Sub but returns ErrOverflow if a-b does not
+// fit in int16.
+func Int16SubChecked(a, b *int16) (*int16, error) {
+	return SubChecked(a, b)
+}
+
+// Int16MulChecked is like Int16Mul but returns ErrOverflow if a*b does not
+// fit in int16.
+func Int16MulChecked(a, b *int16) (*int16, error) {
+	return MulChecked(a, b)
+}
+
+// Int16DivChecked is like Int16Div but returns ErrOverflow if a/b does not
+// fit in int16.
+func Int16DivChecked(a, b *int16) (*int16, error) {
+	return DivChecked(a, b)
+}
+
+// Int16NegChecked is like Int16Neg but returns ErrOverflow if -a does not
+// fit in int16.
+func Int16NegChecked(a *int16) (*int16, error) {
+	return NegChecked(a)
+}
+
+// Int16AbsChecked is like Int16Abs but returns ErrOverflow if the absolute
+// value of a does not fit in int16.
+func Int16AbsChecked(a *int16) (*int16, error) {
+	return AbsChecked(a)
+}
+
+// Int32Add returns a pointer to a+b, or nil if a or b is nil.
+func Int32Add(a, b *int32) *int32 {
+	return Add(a, b)
+}
+
+// Int32Sub returns a pointer to a-b, or nil if a or b is nil.
+func Int32Sub(a, b *int32) *int32 {
+	return Sub(a, b)
+}
+
+// Int32Mul returns a pointer to a*b, or nil if a or b is nil.
+func Int32Mul(a, b *int32) *int32 {
+	return Mul(a, b)
+}
+
+// Int32Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Int32Div(a, b *int32) (*int32, error) {
+	return Div(a, b)
+}
+
+// Int32Neg returns a pointer to -a, or nil if a is nil.
+func Int32Neg(a *int32) *int32 {
+	return Neg(a)
+}
+
+// Int32Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Int32Abs(a *int32) *int32 {
+	return Abs(a)
+}
+
+// Int32AddChecked is like Int32Add but returns ErrOverflow if a+b does not
+// fit in int32.
+func Int32AddChecked(a, b *int32) (*int32, error) {
+	return AddChecked(a, b)
+}
+
+// Int32SubChecked is like Int32Sub but returns ErrOverflow if a-b does not
+// fit in int32.
+func Int32SubChecked(a, b *int32) (*int32, error) {
+	return SubChecked(a, b)
+}
+
+// Int32MulChecked is like Int32Mul but returns ErrOverflow if a*b does not
+// fit in int32.
+func Int32MulChecked(a, b *int32) (*int32, error) {
+	return MulChecked(a, b)
+}
+
+// Int32DivChecked is like Int32Div but returns ErrOverflow if a/b does not
+// fit in int32.
+func Int32DivChecked(a, b *int32) (*int32, error) {
+	return DivChecked(a, b)
+}
+
+// Int32NegChecked is like Int32Neg but returns ErrOverflow if -a does not
+// fit in int32.
+func Int32NegChecked(a *int32) (*int32, error) {
+	return NegChecked(a)
+}
+
+// Int32AbsChecked is like Int32Abs but returns ErrOverflow if the absolute
+// value of a does not fit in int32.
+func Int32AbsChecked(a *int32) (*int32, error) {
+	return AbsChecked(a)
+}
+
+// Int64Add returns a pointer to a+b, or nil if a or b is nil.
+func Int64Add(a, b *int64) *int64 {
+	return Add(a, b)
+}
+
+// Int64Sub returns a pointer to a-b, or nil if a or b is nil.
+func Int64Sub(a, b *int64) *int64 {
+	return Sub(a, b)
+}
+
+// Int64Mul returns a pointer to a*b, or nil if a or b is nil.
+func Int64Mul(a, b *int64) *int64 {
+	return Mul(a, b)
+}
+
+// Int64Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Int64Div(a, b *int64) (*int64, error) {
+	return Div(a, b)
+}
+
+// Int64Neg returns a pointer to -a, or nil if a is nil.
+func Int64Neg(a *int64) *int64 {
+	return Neg(a)
+}
+
+// Int64Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Int64Abs(a *int64) *int64 {
+	return Abs(a)
+}
+
+// Int64AddChecked is like Int64Add but returns ErrOverflow if a+b does not
+// fit in int64.
+func Int64AddChecked(a, b *int64) (*int64, error) {
+	return AddChecked(a, b)
+}
+
+// Int64SubChecked is like Int64Sub but returns ErrOverflow if a-b does not
+// fit in int64.
+func Int64SubChecked(a, b *int64) (*int64, error) {
+	return SubChecked(a, b)
+}
+
+// Int64MulChecked is like Int64Mul but returns ErrOverflow if a*b does not
+// fit in int64.
+func Int64MulChecked(a, b *int64) (*int64, error) {
+	return MulChecked(a, b)
+}
+
+// Int64DivChecked is like Int64Div but returns ErrOverflow if a/b does not
+// fit in int64.
+func Int64DivChecked(a, b *int64) (*int64, error) {
+	return DivChecked(a, b)
+}
+
+// Int64NegChecked is like Int64Neg but returns ErrOverflow if -a does not
+// fit in int64.
+func Int64NegChecked(a *int64) (*int64, error) {
+	return NegChecked(a)
+}
+
+// Int64AbsChecked is like Int64Abs but returns ErrOverflow if the absolute
+// value of a does not fit in int64.
+func Int64AbsChecked(a *int64) (*int64, error) {
+	return AbsChecked(a)
+}
+
+// UintAdd returns a pointer to a+b, or nil if a or b is nil.
+func UintAdd(a, b *uint) *uint {
+	return Add(a, b)
+}
+
+// UintSub returns a pointer to a-b, or nil if a or b is nil.
+func UintSub(a, b *uint) *uint {
+	return Sub(a, b)
+}
+
+// UintMul returns a pointer to a*b, or nil if a or b is nil.
+func UintMul(a, b *uint) *uint {
+	return Mul(a, b)
+}
+
+// UintDiv returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func UintDiv(a, b *uint) (*uint, error) {
+	return Div(a, b)
+}
+
+// UintNeg returns a pointer to -a, or nil if a is nil.
+func UintNeg(a *uint) *uint {
+	return Neg(a)
+}
+
+// UintAbs returns a pointer to the absolute value of a, or nil if a is nil.
+func UintAbs(a *uint) *uint {
+	return Abs(a)
+}
+
+// UintAddChecked is like UintAdd but returns ErrOverflow if a+b does not
+// fit in uint.
+func UintAddChecked(a, b *uint) (*uint, error) {
+	return AddChecked(a, b)
+}
+
+// UintSubChecked is like UintSub but returns ErrOverflow if a-b does not
+// fit in uint.
+func UintSubChecked(a, b *uint) (*uint, error) {
+	return SubChecked(a, b)
+}
+
+// UintMulChecked is like UintMul but returns ErrOverflow if a*b does not
+// fit in uint.
+func UintMulChecked(a, b *uint) (*uint, error) {
+	return MulChecked(a, b)
+}
+
+// UintDivChecked is like UintDiv but returns ErrOverflow if a/b does not
+// fit in uint.
+func UintDivChecked(a, b *uint) (*uint, error) {
+	return DivChecked(a, b)
+}
+
+// UintNegChecked is like UintNeg but returns ErrOverflow if -a does not
+// fit in uint.
+func UintNegChecked(a *uint) (*uint, error) {
+	return NegChecked(a)
+}
+
+// UintAbsChecked is like UintAbs but returns ErrOverflow if the absolute
+// value of a does not fit in uint.
+func UintAbsChecked(a *uint) (*uint, error) {
+	return AbsChecked(a)
+}
+
+// Uint8Add returns a pointer to a+b, or nil if a or b is nil.
+func Uint8Add(a, b *uint8) *uint8 {
+	return Add(a, b)
+}
+
+// Uint8Sub returns a pointer to a-b, or nil if a or b is nil.
+func Uint8Sub(a, b *uint8) *uint8 {
+	return Sub(a, b)
+}
+
+// Uint8Mul returns a pointer to a*b, or nil if a or b is nil.
+func Uint8Mul(a, b *uint8) *uint8 {
+	return Mul(a, b)
+}
+
+// Uint8Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Uint8Div(a, b *uint8) (*uint8, error) {
+	return Div(a, b)
+}
+
+// Uint8Neg returns a pointer to -a, or nil if a is nil.
+func Uint8Neg(a *uint8) *uint8 {
+	return Neg(a)
+}
+
+// Uint8Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Uint8Abs(a *uint8) *uint8 {
+	return Abs(a)
+}
+
+// Uint8AddChecked is like Uint8Add but returns ErrOverflow if a+b does not
+// fit in uint8.
+func Uint8AddChecked(a, b *uint8) (*uint8, error) {
+	return AddChecked(a, b)
+}
+
+// Uint8SubChecked is like Uint8Sub but returns ErrOverflow if a-b does not
+// fit in uint8.
+func Uint8SubChecked(a, b *uint8) (*uint8, error) {
+	return SubChecked(a, b)
+}
+
+// Uint8MulChecked is like Uint8Mul but returns ErrOverflow if a*b does not
+// fit in uint8.
+func Uint8MulChecked(a, b *uint8) (*uint8, error) {
+	return MulChecked(a, b)
+}
+
+// Uint8DivChecked is like Uint8Div but returns ErrOverflow if a/b does not
+// fit in uint8.
+func Uint8DivChecked(a, b *uint8) (*uint8, error) {
+	return DivChecked(a, b)
+}
+
+// Uint8NegChecked is like Uint8Neg but returns ErrOverflow if -a does not
+// fit in uint8.
+func Uint8NegChecked(a *uint8) (*uint8, error) {
+	return NegChecked(a)
+}
+
+// Uint8AbsChecked is like Uint8Abs but returns ErrOverflow if the absolute
+// value of a does not fit in uint8.
+func Uint8AbsChecked(a *uint8) (*uint8, error) {
+	return AbsChecked(a)
+}
+
+// Uint16Add returns a pointer to a+b, or nil if a or b is nil.
+func Uint16Add(a, b *uint16) *uint16 {
+	return Add(a, b)
+}
+
+// Uint16Sub returns a pointer to a-b, or nil if a or b is nil.
+func Uint16Sub(a, b *uint16) *uint16 {
+	return Sub(a, b)
+}
+
+// Uint16Mul returns a pointer to a*b, or nil if a or b is nil.
+func Uint16Mul(a, b *uint16) *uint16 {
+	return Mul(a, b)
+}
+
+// Uint16Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Uint16Div(a, b *uint16) (*uint16, error) {
+	return Div(a, b)
+}
+
+// Uint16Neg returns a pointer to -a, or nil if a is nil.
+func Uint16Neg(a *uint16) *uint16 {
+	return Neg(a)
+}
+
+// Uint16Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Uint16Abs(a *uint16) *uint16 {
+	return Abs(a)
+}
+
+// Uint16AddChecked is like Uint16Add but returns ErrOverflow if a+b does not
+// fit in uint16.
+func Uint16AddChecked(a, b *uint16) (*uint16, error) {
+	return AddChecked(a, b)
+}
+
+// Uint16SubChecked is like Uint16Sub but returns ErrOverflow if a-b does not
+// fit in uint16.
+func Uint16SubChecked(a, b *uint16) (*uint16, error) {
+	return SubChecked(a, b)
+}
+
+// Uint16MulChecked is like Uint16Mul but returns ErrOverflow if a*b does not
+// fit in uint16.
+func Uint16MulChecked(a, b *uint16) (*uint16, error) {
+	return MulChecked(a, b)
+}
+
+// Uint16DivChecked is like Uint16Div but returns ErrOverflow if a/b does not
+// fit in uint16.
+func Uint16DivChecked(a, b *uint16) (*uint16, error) {
+	return DivChecked(a, b)
+}
+
+// Uint16NegChecked is like Uint16Neg but returns ErrOverflow if -a does not
+// fit in uint16.
+func Uint16NegChecked(a *uint16) (*uint16, error) {
+	return NegChecked(a)
+}
+
+// Uint16AbsChecked is like Uint16Abs but returns ErrOverflow if the absolute
+// value of a does not fit in uint16.
+func Uint16AbsChecked(a *uint16) (*uint16, error) {
+	return AbsChecked(a)
+}
+
+// Uint32Add returns a pointer to a+b, or nil if a or b is nil.
+func Uint32Add(a, b *uint32) *uint32 {
+	return Add(a, b)
+}
+
+// Uint32Sub returns a pointer to a-b, or nil if a or b is nil.
+func Uint32Sub(a, b *uint32) *uint32 {
+	return Sub(a, b)
+}
+
+// Uint32Mul returns a pointer to a*b, or nil if a or b is nil.
+func Uint32Mul(a, b *uint32) *uint32 {
+	return Mul(a, b)
+}
+
+// Uint32Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Uint32Div(a, b *uint32) (*uint32, error) {
+	return Div(a, b)
+}
+
+// Uint32Neg returns a pointer to -a, or nil if a is nil.
+func Uint32Neg(a *uint32) *uint32 {
+	return Neg(a)
+}
+
+// Uint32Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Uint32Abs(a *uint32) *uint32 {
+	return Abs(a)
+}
+
+// Uint32AddChecked is like Uint32Add but returns ErrOverflow if a+b does not
+// fit in uint32.
+func Uint32AddChecked(a, b *uint32) (*uint32, error) {
+	return AddChecked(a, b)
+}
+
+// Uint32SubChecked is like Uint32Sub but returns ErrOverflow if a-b does not
+// fit in uint32.
+func Uint32SubChecked(a, b *uint32) (*uint32, error) {
+	return SubChecked(a, b)
+}
+
+// Uint32MulChecked is like Uint32Mul but returns ErrOverflow if a*b does not
+// fit in uint32.
+func Uint32MulChecked(a, b *uint32) (*uint32, error) {
+	return MulChecked(a, b)
+}
+
+// Uint32DivChecked is like Uint32Div but returns ErrOverflow if a/b does not
+// fit in uint32.
+func Uint32DivChecked(a, b *uint32) (*uint32, error) {
+	return DivChecked(a, b)
+}
+
+// Uint32NegChecked is like Uint32Neg but returns ErrOverflow if -a does not
+// fit in uint32.
+func Uint32NegChecked(a *uint32) (*uint32, error) {
+	return NegChecked(a)
+}
+
+// Uint32AbsChecked is like Uint32Abs but returns ErrOverflow if the absolute
+// value of a does not fit in uint32.
+func Uint32AbsChecked(a *uint32) (*uint32, error) {
+	return AbsChecked(a)
+}
+
+// Uint64Add returns a pointer to a+b, or nil if a or b is nil.
+func Uint64Add(a, b *uint64) *uint64 {
+	return Add(a, b)
+}
+
+// Uint64Sub returns a pointer to a-b, or nil if a or b is nil.
+func Uint64Sub(a, b *uint64) *uint64 {
+	return Sub(a, b)
+}
+
+// Uint64Mul returns a pointer to a*b, or nil if a or b is nil.
+func Uint64Mul(a, b *uint64) *uint64 {
+	return Mul(a, b)
+}
+
+// Uint64Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Uint64Div(a, b *uint64) (*uint64, error) {
+	return Div(a, b)
+}
+
+// Uint64Neg returns a pointer to -a, or nil if a is nil.
+func Uint64Neg(a *uint64) *uint64 {
+	return Neg(a)
+}
+
+// Uint64Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Uint64Abs(a *uint64) *uint64 {
+	return Abs(a)
+}
+
+// Uint64AddChecked is like Uint64Add but returns ErrOverflow if a+b does not
+// fit in uint64.
+func Uint64AddChecked(a, b *uint64) (*uint64, error) {
+	return AddChecked(a, b)
+}
+
+// Uint64SubChecked is like Uint64Sub but returns ErrOverflow if a-b does not
+// fit in uint64.
+func Uint64SubChecked(a, b *uint64) (*uint64, error) {
+	return SubChecked(a, b)
+}
+
+// Uint64MulChecked is like Uint64Mul but returns ErrOverflow if a*b does not
+// fit in uint64.
+func Uint64MulChecked(a, b *uint64) (*uint64, error) {
+	return MulChecked(a, b)
+}
+
+// Uint64DivChecked is like Uint64Div but returns ErrOverflow if a/b does not
+// fit in uint64.
+func Uint64DivChecked(a, b *uint64) (*uint64, error) {
+	return DivChecked(a, b)
+}
+
+// Uint64NegChecked is like Uint64Neg but returns ErrOverflow if -a does not
+// fit in uint64.
+func Uint64NegChecked(a *uint64) (*uint64, error) {
+	return NegChecked(a)
+}
+
+// Uint64AbsChecked is like Uint64Abs but returns ErrOverflow if the absolute
+// value of a does not fit in uint64.
+func Uint64AbsChecked(a *uint64) (*uint64, error) {
+	return AbsChecked(a)
+}
+
+// Float32Add returns a pointer to a+b, or nil if a or b is nil.
+func Float32Add(a, b *float32) *float32 {
+	return Add(a, b)
+}
+
+// Float32Sub returns a pointer to a-b, or nil if a or b is nil.
+func Float32Sub(a, b *float32) *float32 {
+	return Sub(a, b)
+}
+
+// Float32Mul returns a pointer to a*b, or nil if a or b is nil.
+func Float32Mul(a, b *float32) *float32 {
+	return Mul(a, b)
+}
+
+// Float32Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Float32Div(a, b *float32) (*float32, error) {
+	return Div(a, b)
+}
+
+// Float32Neg returns a pointer to -a, or nil if a is nil.
+func Float32Neg(a *float32) *float32 {
+	return Neg(a)
+}
+
+// Float32Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Float32Abs(a *float32) *float32 {
+	return Abs(a)
+}
+
+// Float64Add returns a pointer to a+b, or nil if a or b is nil.
+func Float64Add(a, b *float64) *float64 {
+	return Add(a, b)
+}
+
+// Float64Sub returns a pointer to a-b, or nil if a or b is nil.
+func Float64Sub(a, b *float64) *float64 {
+	return Sub(a, b)
+}
+
+// Float64Mul returns a pointer to a*b, or nil if a or b is nil.
+func Float64Mul(a, b *float64) *float64 {
+	return Mul(a, b)
+}
+
+// Float64Div returns a pointer to a/b, or nil if a or b is nil. It returns
+// ErrDivisionByZero if b points to zero.
+func Float64Div(a, b *float64) (*float64, error) {
+	return Div(a, b)
+}
+
+// Float64Neg returns a pointer to -a, or nil if a is nil.
+func Float64Neg(a *float64) *float64 {
+	return Neg(a)
+}
+
+// Float64Abs returns a pointer to the absolute value of a, or nil if a is nil.
+func Float64Abs(a *float64) *float64 {
+	return Abs(a)
+}
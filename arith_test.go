@@ -0,0 +1,143 @@
+package pointer
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+var testCasesArith = []struct {
+	op       func(a, b *int64) *int64
+	a, b     *int64
+	expected *int64
+}{
+	{Add[int64], Int64P(2), Int64P(3), Int64P(5)},
+	{Add[int64], nil, Int64P(3), nil},
+	{Add[int64], Int64P(2), nil, nil},
+	{Sub[int64], Int64P(2), Int64P(3), Int64P(-1)},
+	{Sub[int64], nil, nil, nil},
+	{Mul[int64], Int64P(2), Int64P(-3), Int64P(-6)},
+	{Mul[int64], Int64P(2), nil, nil},
+	{Int64Add, Int64P(math.MaxInt64), Int64P(1), Int64P(math.MinInt64)},
+	{func(a, b *int64) *int64 { return Add(OrZero(a), OrZero(b)) }, nil, Int64P(3), Int64P(3)},
+	{func(a, b *int64) *int64 { return Mul(OrZero(a), OrZero(b)) }, nil, nil, Int64P(0)},
+	{func(a, _ *int64) *int64 { return Neg(a) }, Int64P(2), nil, Int64P(-2)},
+	{func(a, _ *int64) *int64 { return Int64Neg(a) }, nil, nil, nil},
+	{func(a, _ *int64) *int64 { return Abs(a) }, Int64P(-2), nil, Int64P(2)},
+	{func(a, _ *int64) *int64 { return Int64Abs(a) }, Int64P(2), nil, Int64P(2)},
+	{func(a, _ *int64) *int64 { return Abs(a) }, nil, nil, nil},
+}
+
+func TestArith(t *testing.T) {
+	for idx, c := range testCasesArith {
+		a := c.op(c.a, c.b)
+		if (a == nil) != (c.expected == nil) || (a != nil && *a != *c.expected) {
+			t.Errorf("Unexpected result at idx %d, expected %v, got %v", idx, Sprint(c.expected), Sprint(a))
+		}
+	}
+}
+
+func TestArithOperandsUnchanged(t *testing.T) {
+	a, b := Float64P(-1.5), Float64P(2)
+	Float64Add(a, b)
+	Float64Neg(a)
+	Float64Abs(a)
+	if *a != -1.5 || *b != 2 {
+		t.Errorf("Unexpected modification of operands, got %v and %v", *a, *b)
+	}
+	if r := Float64Abs(a); r == a || *r != 1.5 {
+		t.Errorf("Unexpected result %v", *r)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	if r, err := Div(Float64P(1), Float64P(4)); err != nil || *r != 0.25 {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+	if r, err := IntDiv(IntP(7), IntP(2)); err != nil || *r != 3 {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+	if r, err := Div(nil, Float64P(0)); err != nil || r != nil {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+	if r, err := Float64Div(Float64P(1), Float64P(0)); !errors.Is(err, ErrDivisionByZero) || r != nil {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+	if r, err := Uint8DivChecked(Uint8P(1), Uint8P(0)); !errors.Is(err, ErrDivisionByZero) || r != nil {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+}
+
+// checkedOp compares a checked operation on int8 or uint8 against the
+// exact result computed in int64.
+func checkedOp[T int8 | uint8](t *testing.T, name string, fn func(a, b *T) (*T, error), exact func(a, b int64) (int64, bool)) {
+	t.Helper()
+	lo, hi := int64(math.MinInt8), int64(math.MaxInt8)
+	if ^T(0) > 0 {
+		lo, hi = 0, math.MaxUint8
+	}
+	for x := lo; x <= hi; x++ {
+		for y := lo; y <= hi; y++ {
+			e, defined := exact(x, y)
+			a, b := T(x), T(y)
+			r, err := fn(&a, &b)
+			switch {
+			case !defined:
+				if !errors.Is(err, ErrDivisionByZero) {
+					t.Fatalf("Unexpected %s(%d, %d), expected division by zero, got %v, %v", name, x, y, Sprint(r), err)
+				}
+			case e < lo || e > hi:
+				if !errors.Is(err, ErrOverflow) || r != nil {
+					t.Fatalf("Unexpected %s(%d, %d), expected overflow, got %v, %v", name, x, y, Sprint(r), err)
+				}
+			case err != nil || int64(*r) != e:
+				t.Fatalf("Unexpected %s(%d, %d), expected %d, got %v, %v", name, x, y, e, Sprint(r), err)
+			}
+		}
+	}
+	if r, err := fn(nil, new(T)); r != nil || err != nil {
+		t.Errorf("Unexpected %s with nil operand, got %v, %v", name, Sprint(r), err)
+	}
+}
+
+func testChecked[T int8 | uint8](t *testing.T) {
+	checkedOp[T](t, "AddChecked", AddChecked[T], func(a, b int64) (int64, bool) { return a + b, true })
+	checkedOp[T](t, "SubChecked", SubChecked[T], func(a, b int64) (int64, bool) { return a - b, true })
+	checkedOp[T](t, "MulChecked", MulChecked[T], func(a, b int64) (int64, bool) { return a * b, true })
+	checkedOp[T](t, "DivChecked", DivChecked[T], func(a, b int64) (int64, bool) {
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	})
+	checkedOp[T](t, "NegChecked", func(a, _ *T) (*T, error) { return NegChecked(a) }, func(a, _ int64) (int64, bool) { return -a, true })
+	checkedOp[T](t, "AbsChecked", func(a, _ *T) (*T, error) { return AbsChecked(a) }, func(a, _ int64) (int64, bool) {
+		if a < 0 {
+			return -a, true
+		}
+		return a, true
+	})
+}
+
+func TestChecked(t *testing.T) {
+	testChecked[int8](t)
+	testChecked[uint8](t)
+}
+
+func TestCheckedWrappers(t *testing.T) {
+	if _, err := Int64AddChecked(Int64P(math.MaxInt64), Int64P(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if _, err := Int64MulChecked(Int64P(math.MinInt64), Int64P(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if _, err := IntDivChecked(IntP(math.MinInt), IntP(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if _, err := Uint64SubChecked(Uint64P(0), Uint64P(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow, got %v", err)
+	}
+	if r, err := Int32AbsChecked(Int32P(math.MinInt32 + 1)); err != nil || *r != math.MaxInt32 {
+		t.Errorf("Unexpected result %v, %v", Sprint(r), err)
+	}
+}
@@ -0,0 +1,391 @@
+package pointer
+
+import (
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// Load atomically loads *addr.
+func Load[T any](addr **T) *T {
+	return (*T)(atomic.LoadPointer((*unsafe.Pointer)(unsafe.Pointer(addr))))
+}
+
+// Store atomically stores v into *addr.
+func Store[T any](addr **T, v *T) {
+	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(addr)), unsafe.Pointer(v))
+}
+
+// Swap atomically stores v into *addr and returns the previous pointer.
+func Swap[T any](addr **T, v *T) *T {
+	return (*T)(atomic.SwapPointer((*unsafe.Pointer)(unsafe.Pointer(addr)), unsafe.Pointer(v)))
+}
+
+// CompareAndSwap atomically replaces *addr with a pointer to new if the
+// value it points to equals old. A nil pointer is treated as the zero
+// value of T, so comparing against the zero value matches nil too.
+func CompareAndSwap[T comparable](addr **T, old, new T) bool {
+	return compareAndSwapFunc(addr, old, new, func(a, b T) bool { return a == b })
+}
+
+func compareAndSwapFunc[T any](addr **T, old, new T, eq func(a, b T) bool) bool {
+	p := (*unsafe.Pointer)(unsafe.Pointer(addr))
+	for {
+		cur := atomic.LoadPointer(p)
+		var v T
+		if cur != nil {
+			v = *(*T)(cur)
+		}
+		if !eq(v, old) {
+			return false
+		}
+		if atomic.CompareAndSwapPointer(p, cur, unsafe.Pointer(&new)) {
+			return true
+		}
+	}
+}
+
+// LoadString atomically loads *addr and returns the string value it points to
+// or "" if the pointer is nil.
+func LoadString(addr **string) string {
+	return String(Load(addr))
+}
+
+// StoreString atomically stores a pointer to the string value v into *addr.
+func StoreString(addr **string, v string) {
+	Store(addr, &v)
+}
+
+// SwapString atomically stores a pointer to the string value v into *addr and
+// returns the previous value or "" if the previous pointer was nil.
+func SwapString(addr **string, v string) string {
+	return String(Swap(addr, &v))
+}
+
+// CompareAndSwapString atomically replaces *addr with a pointer to new if the
+// string value it points to equals old. A nil pointer equals "".
+func CompareAndSwapString(addr **string, old, new string) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadBool atomically loads *addr and returns the bool value it points to
+// or false if the pointer is nil.
+func LoadBool(addr **bool) bool {
+	return Bool(Load(addr))
+}
+
+// StoreBool atomically stores a pointer to the bool value v into *addr.
+func StoreBool(addr **bool, v bool) {
+	Store(addr, &v)
+}
+
+// SwapBool atomically stores a pointer to the bool value v into *addr and
+// returns the previous value or false if the previous pointer was nil.
+func SwapBool(addr **bool, v bool) bool {
+	return Bool(Swap(addr, &v))
+}
+
+// CompareAndSwapBool atomically replaces *addr with a pointer to new if the
+// bool value it points to equals old. A nil pointer equals false.
+func CompareAndSwapBool(addr **bool, old, new bool) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadInt atomically loads *addr and returns the int value it points to
+// or 0 if the pointer is nil.
+func LoadInt(addr **int) int {
+	return Int(Load(addr))
+}
+
+// StoreInt atomically stores a pointer to the int value v into *addr.
+func StoreInt(addr **int, v int) {
+	Store(addr, &v)
+}
+
+// SwapInt atomically stores a pointer to the int value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapInt(addr **int, v int) int {
+	return Int(Swap(addr, &v))
+}
+
+// CompareAndSwapInt atomically replaces *addr with a pointer to new if the
+// int value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapInt(addr **int, old, new int) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadUint atomically loads *addr and returns the uint value it points to
+// or 0 if the pointer is nil.
+func LoadUint(addr **uint) uint {
+	return Uint(Load(addr))
+}
+
+// StoreUint atomically stores a pointer to the uint value v into *addr.
+func StoreUint(addr **uint, v uint) {
+	Store(addr, &v)
+}
+
+// SwapUint atomically stores a pointer to the uint value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapUint(addr **uint, v uint) uint {
+	return Uint(Swap(addr, &v))
+}
+
+// CompareAndSwapUint atomically replaces *addr with a pointer to new if the
+// uint value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapUint(addr **uint, old, new uint) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadInt8 atomically loads *addr and returns the int8 value it points to
+// or 0 if the pointer is nil.
+func LoadInt8(addr **int8) int8 {
+	return Int8(Load(addr))
+}
+
+// StoreInt8 atomically stores a pointer to the int8 value v into *addr.
+func StoreInt8(addr **int8, v int8) {
+	Store(addr, &v)
+}
+
+// SwapInt8 atomically stores a pointer to the int8 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapInt8(addr **int8, v int8) int8 {
+	return Int8(Swap(addr, &v))
+}
+
+// CompareAndSwapInt8 atomically replaces *addr with a pointer to new if the
+// int8 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapInt8(addr **int8, old, new int8) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadInt16 atomically loads *addr and returns the int16 value it points to
+// or 0 if the pointer is nil.
+func LoadInt16(addr **int16) int16 {
+	return Int16(Load(addr))
+}
+
+// StoreInt16 atomically stores a pointer to the int16 value v into *addr.
+func StoreInt16(addr **int16, v int16) {
+	Store(addr, &v)
+}
+
+// SwapInt16 atomically stores a pointer to the int16 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapInt16(addr **int16, v int16) int16 {
+	return Int16(Swap(addr, &v))
+}
+
+// CompareAndSwapInt16 atomically replaces *addr with a pointer to new if the
+// int16 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapInt16(addr **int16, old, new int16) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadInt32 atomically loads *addr and returns the int32 value it points to
+// or 0 if the pointer is nil.
+func LoadInt32(addr **int32) int32 {
+	return Int32(Load(addr))
+}
+
+// StoreInt32 atomically stores a pointer to the int32 value v into *addr.
+func StoreInt32(addr **int32, v int32) {
+	Store(addr, &v)
+}
+
+// SwapInt32 atomically stores a pointer to the int32 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapInt32(addr **int32, v int32) int32 {
+	return Int32(Swap(addr, &v))
+}
+
+// CompareAndSwapInt32 atomically replaces *addr with a pointer to new if the
+// int32 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapInt32(addr **int32, old, new int32) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadInt64 atomically loads *addr and returns the int64 value it points to
+// or 0 if the pointer is nil.
+func LoadInt64(addr **int64) int64 {
+	return Int64(Load(addr))
+}
+
+// StoreInt64 atomically stores a pointer to the int64 value v into *addr.
+func StoreInt64(addr **int64, v int64) {
+	Store(addr, &v)
+}
+
+// SwapInt64 atomically stores a pointer to the int64 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapInt64(addr **int64, v int64) int64 {
+	return Int64(Swap(addr, &v))
+}
+
+// CompareAndSwapInt64 atomically replaces *addr with a pointer to new if the
+// int64 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapInt64(addr **int64, old, new int64) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadUint8 atomically loads *addr and returns the uint8 value it points to
+// or 0 if the pointer is nil.
+func LoadUint8(addr **uint8) uint8 {
+	return Uint8(Load(addr))
+}
+
+// StoreUint8 atomically stores a pointer to the uint8 value v into *addr.
+func StoreUint8(addr **uint8, v uint8) {
+	Store(addr, &v)
+}
+
+// SwapUint8 atomically stores a pointer to the uint8 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapUint8(addr **uint8, v uint8) uint8 {
+	return Uint8(Swap(addr, &v))
+}
+
+// CompareAndSwapUint8 atomically replaces *addr with a pointer to new if the
+// uint8 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapUint8(addr **uint8, old, new uint8) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadUint16 atomically loads *addr and returns the uint16 value it points to
+// or 0 if the pointer is nil.
+func LoadUint16(addr **uint16) uint16 {
+	return Uint16(Load(addr))
+}
+
+// StoreUint16 atomically stores a pointer to the uint16 value v into *addr.
+func StoreUint16(addr **uint16, v uint16) {
+	Store(addr, &v)
+}
+
+// SwapUint16 atomically stores a pointer to the uint16 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapUint16(addr **uint16, v uint16) uint16 {
+	return Uint16(Swap(addr, &v))
+}
+
+// CompareAndSwapUint16 atomically replaces *addr with a pointer to new if the
+// uint16 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapUint16(addr **uint16, old, new uint16) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadUint32 atomically loads *addr and returns the uint32 value it points to
+// or 0 if the pointer is nil.
+func LoadUint32(addr **uint32) uint32 {
+	return Uint32(Load(addr))
+}
+
+// StoreUint32 atomically stores a pointer to the uint32 value v into *addr.
+func StoreUint32(addr **uint32, v uint32) {
+	Store(addr, &v)
+}
+
+// SwapUint32 atomically stores a pointer to the uint32 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapUint32(addr **uint32, v uint32) uint32 {
+	return Uint32(Swap(addr, &v))
+}
+
+// CompareAndSwapUint32 atomically replaces *addr with a pointer to new if the
+// uint32 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapUint32(addr **uint32, old, new uint32) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadUint64 atomically loads *addr and returns the uint64 value it points to
+// or 0 if the pointer is nil.
+func LoadUint64(addr **uint64) uint64 {
+	return Uint64(Load(addr))
+}
+
+// StoreUint64 atomically stores a pointer to the uint64 value v into *addr.
+func StoreUint64(addr **uint64, v uint64) {
+	Store(addr, &v)
+}
+
+// SwapUint64 atomically stores a pointer to the uint64 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapUint64(addr **uint64, v uint64) uint64 {
+	return Uint64(Swap(addr, &v))
+}
+
+// CompareAndSwapUint64 atomically replaces *addr with a pointer to new if the
+// uint64 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapUint64(addr **uint64, old, new uint64) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadFloat32 atomically loads *addr and returns the float32 value it points to
+// or 0 if the pointer is nil.
+func LoadFloat32(addr **float32) float32 {
+	return Float32(Load(addr))
+}
+
+// StoreFloat32 atomically stores a pointer to the float32 value v into *addr.
+func StoreFloat32(addr **float32, v float32) {
+	Store(addr, &v)
+}
+
+// SwapFloat32 atomically stores a pointer to the float32 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapFloat32(addr **float32, v float32) float32 {
+	return Float32(Swap(addr, &v))
+}
+
+// CompareAndSwapFloat32 atomically replaces *addr with a pointer to new if the
+// float32 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapFloat32(addr **float32, old, new float32) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadFloat64 atomically loads *addr and returns the float64 value it points to
+// or 0 if the pointer is nil.
+func LoadFloat64(addr **float64) float64 {
+	return Float64(Load(addr))
+}
+
+// StoreFloat64 atomically stores a pointer to the float64 value v into *addr.
+func StoreFloat64(addr **float64, v float64) {
+	Store(addr, &v)
+}
+
+// SwapFloat64 atomically stores a pointer to the float64 value v into *addr and
+// returns the previous value or 0 if the previous pointer was nil.
+func SwapFloat64(addr **float64, v float64) float64 {
+	return Float64(Swap(addr, &v))
+}
+
+// CompareAndSwapFloat64 atomically replaces *addr with a pointer to new if the
+// float64 value it points to equals old. A nil pointer equals 0.
+func CompareAndSwapFloat64(addr **float64, old, new float64) bool {
+	return CompareAndSwap(addr, old, new)
+}
+
+// LoadTime atomically loads *addr and returns the time.Time value it points to
+// or time.Time{} if the pointer is nil.
+func LoadTime(addr **time.Time) time.Time {
+	return Time(Load(addr))
+}
+
+// StoreTime atomically stores a pointer to the time.Time value v into *addr.
+func StoreTime(addr **time.Time, v time.Time) {
+	Store(addr, &v)
+}
+
+// SwapTime atomically stores a pointer to the time.Time value v into *addr and
+// returns the previous value or time.Time{} if the previous pointer was nil.
+func SwapTime(addr **time.Time, v time.Time) time.Time {
+	return Time(Swap(addr, &v))
+}
+
+// CompareAndSwapTime atomically replaces *addr with a pointer to new if the
+// time.Time value it points to equals old. A nil pointer equals time.Time{}.
+func CompareAndSwapTime(addr **time.Time, old, new time.Time) bool {
+	return compareAndSwapFunc(addr, old, new, time.Time.Equal)
+}
@@ -0,0 +1,86 @@
+package pointer
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type atomicTestConfig struct {
+	Replicas *int64
+	Name     *string
+	Enabled  *bool
+	Deadline *time.Time
+}
+
+func TestAtomicNilZero(t *testing.T) {
+	var cfg atomicTestConfig
+	if e, a := int64(0), LoadInt64(&cfg.Replicas); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "", SwapString(&cfg.Name, "a"); e != a {
+		t.Errorf("Expected %q, got %q", e, a)
+	}
+	if e, a := "a", LoadString(&cfg.Name); e != a {
+		t.Errorf("Expected %q, got %q", e, a)
+	}
+	if !CompareAndSwapBool(&cfg.Enabled, false, true) {
+		t.Errorf("Expected nil pointer to compare equal to false")
+	}
+	if !LoadBool(&cfg.Enabled) {
+		t.Errorf("Expected value to be swapped")
+	}
+	if CompareAndSwapBool(&cfg.Enabled, false, true) {
+		t.Errorf("Expected compare and swap to fail")
+	}
+}
+
+func TestAtomicTime(t *testing.T) {
+	var cfg atomicTestConfig
+	now := time.Now()
+	StoreTime(&cfg.Deadline, now)
+	if !CompareAndSwapTime(&cfg.Deadline, now.UTC(), now.Add(time.Hour)) {
+		t.Errorf("Expected times in different locations to compare equal")
+	}
+	if e, a := now.Add(time.Hour), LoadTime(&cfg.Deadline); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestAtomicConcurrent(t *testing.T) {
+	var cfg atomicTestConfig
+	var wg sync.WaitGroup
+	const workers, iterations = 8, 1000
+
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				for {
+					old := LoadInt64(&cfg.Replicas)
+					if CompareAndSwapInt64(&cfg.Replicas, old, old+1) {
+						break
+					}
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				StoreString(&cfg.Name, "worker")
+				_ = LoadString(&cfg.Name)
+				_ = Swap(&cfg.Enabled, BoolP(j%2 == 0))
+				_ = Load(&cfg.Enabled)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if e, a := int64(workers*iterations), LoadInt64(&cfg.Replicas); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "worker", LoadString(&cfg.Name); e != a {
+		t.Errorf("Expected %q, got %q", e, a)
+	}
+}
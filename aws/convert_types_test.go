@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStringParity(t *testing.T) {
+	if e, a := "a", StringValue(String("a")); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "", StringValue(nil); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	in := []string{"a", "b"}
+	out := StringSlice(in)
+	if out[0] != &in[0] {
+		t.Errorf("Expected pointers into the source slice")
+	}
+	if e, a := []string{"a", "", "c"}, StringValueSlice([]*string{String("a"), nil, String("c")}); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected nil elements to become zero values, got %v", a)
+	}
+	if e, a := map[string]string{"a": "1"}, StringValueMap(map[string]*string{"a": String("1"), "b": nil}); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected nil entries to be dropped, got %v", a)
+	}
+	m := StringMap(map[string]string{"a": "1"})
+	if e, a := "1", StringValue(m["a"]); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+var testCasesInt64ValueSlice = [][]*int64{
+	nil,
+	{},
+	{Int64(1), nil, Int64(3)},
+}
+
+func TestInt64Parity(t *testing.T) {
+	for idx, in := range testCasesInt64ValueSlice {
+		out := Int64ValueSlice(in)
+		if e, a := len(in), len(out); e != a {
+			t.Errorf("Unexpected len at idx %d", idx)
+		}
+		for i := range in {
+			if in[i] == nil && out[i] != 0 || in[i] != nil && *in[i] != out[i] {
+				t.Errorf("Unexpected value at idx %d", idx)
+			}
+		}
+	}
+	if Int64ValueMap(nil) == nil {
+		t.Errorf("Expected a non-nil map for nil input")
+	}
+}
+
+func TestTimeParity(t *testing.T) {
+	if !TimeValue(nil).IsZero() {
+		t.Errorf("Expected zero time for nil")
+	}
+	ms := int64(1501558289001)
+	if e, a := time.Unix(1501558289, 0), SecondsTimeValue(&ms); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := time.Unix(1501558289, 1000000), MillisecondsTimeValue(&ms); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := ms, TimeUnixMilli(time.Unix(0, ms*int64(time.Millisecond))); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestOtherTypesParity(t *testing.T) {
+	if !BoolValue(Bool(true)) || BoolValue(nil) {
+		t.Errorf("Unexpected Bool result")
+	}
+	if e, a := []float64{0.5, 0}, Float64ValueSlice([]*float64{Float64(0.5), nil}); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := map[string]uint8{"a": 1}, Uint8ValueMap(Uint8Map(map[string]uint8{"a": 1})); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
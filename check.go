@@ -0,0 +1,393 @@
+package pointer
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ValueError reports a value that does not satisfy a constraint.
+type ValueError struct {
+	// Value is the offending value.
+	Value interface{}
+	// Constraint describes what the value should have been.
+	Constraint string
+}
+
+func (e *ValueError) Error() string {
+	return "pointer: " + formatValue(e.Value) + " is not " + e.Constraint
+}
+
+func formatValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// AllowNil returns nil if err was returned for a nil pointer by one of the
+// validators InRange, Positive, NonNegative or OneOf, and err otherwise.
+// It is meant to be applied to the result of a single validator, e.g.
+// AllowNil(InRange(spec.Replicas, 0, 1000)).
+func AllowNil(err error) error {
+	if errors.Is(err, ErrNilPointer) {
+		return nil
+	}
+	return err
+}
+
+// InRange returns ErrNilPointer if p is nil, and a *ValueError if the value
+// p points to is not within [min, max].
+func InRange[T cmp.Ordered](p *T, min, max T) error {
+	if p == nil {
+		return ErrNilPointer
+	}
+	if !(*p >= min && *p <= max) {
+		return &ValueError{Value: *p, Constraint: fmt.Sprintf("in range [%s, %s]", formatValue(min), formatValue(max))}
+	}
+	return nil
+}
+
+// Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// value p points to is not greater than zero.
+func Positive[T Number](p *T) error {
+	if p == nil {
+		return ErrNilPointer
+	}
+	if !(*p > 0) {
+		return &ValueError{Value: *p, Constraint: "positive"}
+	}
+	return nil
+}
+
+// NonNegative returns ErrNilPointer if p is nil, and a *ValueError if the
+// value p points to is less than zero.
+func NonNegative[T Number](p *T) error {
+	if p == nil {
+		return ErrNilPointer
+	}
+	if !(*p >= 0) {
+		return &ValueError{Value: *p, Constraint: "non-negative"}
+	}
+	return nil
+}
+
+// OneOf returns ErrNilPointer if p is nil, and a *ValueError if the value
+// p points to is not one of allowed.
+func OneOf[T comparable](p *T, allowed ...T) error {
+	if p == nil {
+		return ErrNilPointer
+	}
+	for _, v := range allowed {
+		if *p == v {
+			return nil
+		}
+	}
+	vals := make([]string, len(allowed))
+	for i, v := range allowed {
+		vals[i] = formatValue(v)
+	}
+	return &ValueError{Value: *p, Constraint: "one of [" + strings.Join(vals, ", ") + "]"}
+}
+
+// StringInRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// string p points to does not sort within [min, max].
+func StringInRange(p *string, min, max string) error {
+	return InRange(p, min, max)
+}
+
+// StringOneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// string p points to is not one of allowed.
+func StringOneOf(p *string, allowed ...string) error {
+	return OneOf(p, allowed...)
+}
+
+// IntInRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// int p points to is not within [min, max].
+func IntInRange(p *int, min, max int) error {
+	return InRange(p, min, max)
+}
+
+// IntPositive returns ErrNilPointer if p is nil, and a *ValueError if the
+// int p points to is not greater than zero.
+func IntPositive(p *int) error {
+	return Positive(p)
+}
+
+// IntNonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the int p points to is less than zero.
+func IntNonNegative(p *int) error {
+	return NonNegative(p)
+}
+
+// IntOneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// int p points to is not one of allowed.
+func IntOneOf(p *int, allowed ...int) error {
+	return OneOf(p, allowed...)
+}
+
+// Int8InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// int8 p points to is not within [min, max].
+func Int8InRange(p *int8, min, max int8) error {
+	return InRange(p, min, max)
+}
+
+// Int8Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// int8 p points to is not greater than zero.
+func Int8Positive(p *int8) error {
+	return Positive(p)
+}
+
+// Int8NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the int8 p points to is less than zero.
+func Int8NonNegative(p *int8) error {
+	return NonNegative(p)
+}
+
+// Int8OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// int8 p points to is not one of allowed.
+func Int8OneOf(p *int8, allowed ...int8) error {
+	return OneOf(p, allowed...)
+}
+
+// Int16InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// int16 p points to is not within [min, max].
+func Int16InRange(p *int16, min, max int16) error {
+	return InRange(p, min, max)
+}
+
+// Int16Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// int16 p points to is not greater than zero.
+func Int16Positive(p *int16) error {
+	return Positive(p)
+}
+
+// Int16NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the int16 p points to is less than zero.
+func Int16NonNegative(p *int16) error {
+	return NonNegative(p)
+}
+
+// Int16OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// int16 p points to is not one of allowed.
+func Int16OneOf(p *int16, allowed ...int16) error {
+	return OneOf(p, allowed...)
+}
+
+// Int32InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// int32 p points to is not within [min, max].
+func Int32InRange(p *int32, min, max int32) error {
+	return InRange(p, min, max)
+}
+
+// Int32Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// int32 p points to is not greater than zero.
+func Int32Positive(p *int32) error {
+	return Positive(p)
+}
+
+// Int32NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the int32 p points to is less than zero.
+func Int32NonNegative(p *int32) error {
+	return NonNegative(p)
+}
+
+// Int32OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// int32 p points to is not one of allowed.
+func Int32OneOf(p *int32, allowed ...int32) error {
+	return OneOf(p, allowed...)
+}
+
+// Int64InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// int64 p points to is not within [min, max].
+func Int64InRange(p *int64, min, max int64) error {
+	return InRange(p, min, max)
+}
+
+// Int64Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// int64 p points to is not greater than zero.
+func Int64Positive(p *int64) error {
+	return Positive(p)
+}
+
+// Int64NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the int64 p points to is less than zero.
+func Int64NonNegative(p *int64) error {
+	return NonNegative(p)
+}
+
+// Int64OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// int64 p points to is not one of allowed.
+func Int64OneOf(p *int64, allowed ...int64) error {
+	return OneOf(p, allowed...)
+}
+
+// UintInRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint p points to is not within [min, max].
+func UintInRange(p *uint, min, max uint) error {
+	return InRange(p, min, max)
+}
+
+// UintPositive returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint p points to is not greater than zero.
+func UintPositive(p *uint) error {
+	return Positive(p)
+}
+
+// UintNonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the uint p points to is less than zero.
+func UintNonNegative(p *uint) error {
+	return NonNegative(p)
+}
+
+// UintOneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint p points to is not one of allowed.
+func UintOneOf(p *uint, allowed ...uint) error {
+	return OneOf(p, allowed...)
+}
+
+// Uint8InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint8 p points to is not within [min, max].
+func Uint8InRange(p *uint8, min, max uint8) error {
+	return InRange(p, min, max)
+}
+
+// Uint8Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint8 p points to is not greater than zero.
+func Uint8Positive(p *uint8) error {
+	return Positive(p)
+}
+
+// Uint8NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the uint8 p points to is less than zero.
+func Uint8NonNegative(p *uint8) error {
+	return NonNegative(p)
+}
+
+// Uint8OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint8 p points to is not one of allowed.
+func Uint8OneOf(p *uint8, allowed ...uint8) error {
+	return OneOf(p, allowed...)
+}
+
+// Uint16InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint16 p points to is not within [min, max].
+func Uint16InRange(p *uint16, min, max uint16) error {
+	return InRange(p, min, max)
+}
+
+// Uint16Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint16 p points to is not greater than zero.
+func Uint16Positive(p *uint16) error {
+	return Positive(p)
+}
+
+// Uint16NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the uint16 p points to is less than zero.
+func Uint16NonNegative(p *uint16) error {
+	return NonNegative(p)
+}
+
+// Uint16OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint16 p points to is not one of allowed.
+func Uint16OneOf(p *uint16, allowed ...uint16) error {
+	return OneOf(p, allowed...)
+}
+
+// Uint32InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint32 p points to is not within [min, max].
+func Uint32InRange(p *uint32, min, max uint32) error {
+	return InRange(p, min, max)
+}
+
+// Uint32Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint32 p points to is not greater than zero.
+func Uint32Positive(p *uint32) error {
+	return Positive(p)
+}
+
+// Uint32NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the uint32 p points to is less than zero.
+func Uint32NonNegative(p *uint32) error {
+	return NonNegative(p)
+}
+
+// Uint32OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint32 p points to is not one of allowed.
+func Uint32OneOf(p *uint32, allowed ...uint32) error {
+	return OneOf(p, allowed...)
+}
+
+// Uint64InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint64 p points to is not within [min, max].
+func Uint64InRange(p *uint64, min, max uint64) error {
+	return InRange(p, min, max)
+}
+
+// Uint64Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint64 p points to is not greater than zero.
+func Uint64Positive(p *uint64) error {
+	return Positive(p)
+}
+
+// Uint64NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the uint64 p points to is less than zero.
+func Uint64NonNegative(p *uint64) error {
+	return NonNegative(p)
+}
+
+// Uint64OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// uint64 p points to is not one of allowed.
+func Uint64OneOf(p *uint64, allowed ...uint64) error {
+	return OneOf(p, allowed...)
+}
+
+// Float32InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// float32 p points to is not within [min, max].
+func Float32InRange(p *float32, min, max float32) error {
+	return InRange(p, min, max)
+}
+
+// Float32Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// float32 p points to is not greater than zero.
+func Float32Positive(p *float32) error {
+	return Positive(p)
+}
+
+// Float32NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the float32 p points to is less than zero.
+func Float32NonNegative(p *float32) error {
+	return NonNegative(p)
+}
+
+// Float32OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// float32 p points to is not one of allowed.
+func Float32OneOf(p *float32, allowed ...float32) error {
+	return OneOf(p, allowed...)
+}
+
+// Float64InRange returns ErrNilPointer if p is nil, and a *ValueError if the
+// float64 p points to is not within [min, max].
+func Float64InRange(p *float64, min, max float64) error {
+	return InRange(p, min, max)
+}
+
+// Float64Positive returns ErrNilPointer if p is nil, and a *ValueError if the
+// float64 p points to is not greater than zero.
+func Float64Positive(p *float64) error {
+	return Positive(p)
+}
+
+// Float64NonNegative returns ErrNilPointer if p is nil, and a *ValueError if
+// the float64 p points to is less than zero.
+func Float64NonNegative(p *float64) error {
+	return NonNegative(p)
+}
+
+// Float64OneOf returns ErrNilPointer if p is nil, and a *ValueError if the
+// float64 p points to is not one of allowed.
+func Float64OneOf(p *float64, allowed ...float64) error {
+	return OneOf(p, allowed...)
+}
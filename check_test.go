@@ -0,0 +1,73 @@
+package pointer
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+var testCasesCheck = []struct {
+	err      error
+	expected string
+}{
+	{InRange(Int32P(0), 0, 1000), ""},
+	{InRange(Int32P(1000), 0, 1000), ""},
+	{InRange(Int32P(1001), 0, 1000), "pointer: 1001 is not in range [0, 1000]"},
+	{Float64InRange(Float64P(0.5), 0, 1), ""},
+	{Float64InRange(Float64P(-0.5), 0, 1), "pointer: -0.5 is not in range [0, 1]"},
+	{Float64InRange(Float64P(math.NaN()), 0, 1), "pointer: NaN is not in range [0, 1]"},
+	{StringInRange(StringP("b"), "a", "c"), ""},
+	{StringInRange(StringP("d"), "a", "c"), `pointer: "d" is not in range ["a", "c"]`},
+	{Positive(IntP(1)), ""},
+	{IntPositive(IntP(0)), "pointer: 0 is not positive"},
+	{Uint8Positive(Uint8P(0)), "pointer: 0 is not positive"},
+	{NonNegative(Int64P(0)), ""},
+	{Int64NonNegative(Int64P(-1)), "pointer: -1 is not non-negative"},
+	{Float32NonNegative(Float32P(float32(math.NaN()))), "pointer: NaN is not non-negative"},
+	{OneOf(IntP(2), 1, 2, 3), ""},
+	{Int16OneOf(Int16P(4), 1, 2, 3), "pointer: 4 is not one of [1, 2, 3]"},
+	{StringOneOf(StringP("Always"), "Always", "Never"), ""},
+	{StringOneOf(StringP("Sometimes"), "Always", "Never"), `pointer: "Sometimes" is not one of ["Always", "Never"]`},
+	{StringOneOf(StringP("a")), `pointer: "a" is not one of []`},
+}
+
+func TestCheck(t *testing.T) {
+	for idx, c := range testCasesCheck {
+		var a string
+		if c.err != nil {
+			a = c.err.Error()
+		}
+		if e := c.expected; e != a {
+			t.Errorf("Unexpected error at idx %d, expected %q, got %q", idx, e, a)
+		}
+	}
+}
+
+func TestCheckValue(t *testing.T) {
+	var ve *ValueError
+	if err := Int32InRange(Int32P(-1), 0, 10); !errors.As(err, &ve) || ve.Value != int32(-1) {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestCheckNil(t *testing.T) {
+	errs := []error{
+		InRange[int](nil, 0, 1),
+		Positive[float64](nil),
+		NonNegative[int8](nil),
+		OneOf[string](nil, "a"),
+		UintInRange(nil, 0, 1),
+		StringOneOf(nil),
+	}
+	for idx, err := range errs {
+		if !errors.Is(err, ErrNilPointer) {
+			t.Errorf("Unexpected error at idx %d, expected ErrNilPointer, got %v", idx, err)
+		}
+		if err := AllowNil(err); err != nil {
+			t.Errorf("Unexpected error at idx %d with AllowNil, got %v", idx, err)
+		}
+	}
+	if err := AllowNil(Positive(IntP(0))); err == nil {
+		t.Errorf("Expected AllowNil to keep constraint errors")
+	}
+}
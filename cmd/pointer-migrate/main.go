@@ -0,0 +1,143 @@
+// Command pointer-migrate rewrites calls of the pointer helpers of
+// github.com/aws/aws-sdk-go/aws, k8s.io/utils/pointer and
+// k8s.io/utils/ptr to their gomodules.xyz/pointer equivalents and fixes
+// the imports. Calls it cannot map, such as ptr.Deref with a non-zero
+// default, are reported and left as is.
+//
+// Usage:
+//
+//	pointer-migrate [-w] [-l] path...
+//
+// Paths may be files or directories, which are walked recursively
+// skipping vendor and testdata directories. By default the rewritten
+// files are printed to standard output.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	write = flag.Bool("w", false, "write result to source files instead of standard output")
+	list  = flag.Bool("l", false, "list files whose source changed")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: pointer-migrate [-w] [-l] path...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(paths []string) error {
+	pkgs, err := collect(paths)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(pkgs))
+	for k := range pkgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		filenames := pkgs[k]
+		fset := token.NewFileSet()
+		files := make([]*ast.File, 0, len(filenames))
+		for _, filename := range filenames {
+			f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+			if err != nil {
+				return err
+			}
+			files = append(files, f)
+		}
+		out, issues, err := migratePackage(fset, files)
+		if err != nil {
+			return err
+		}
+		for _, i := range issues {
+			fmt.Fprintln(os.Stderr, i)
+		}
+		for idx, filename := range filenames {
+			src, ok := out[idx]
+			if !ok {
+				continue
+			}
+			if *list {
+				fmt.Println(filename)
+			}
+			if *write {
+				if err := os.WriteFile(filename, src, 0o644); err != nil {
+					return err
+				}
+			} else if !*list {
+				os.Stdout.Write(src)
+			}
+		}
+	}
+	return nil
+}
+
+// collect returns the Go files below paths grouped by directory and
+// package, so that each group can be type checked together.
+func collect(paths []string) (map[string][]string, error) {
+	pkgs := make(map[string][]string)
+	add := func(filename string) error {
+		f, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return err
+		}
+		k := filepath.Dir(filename) + ":" + f.Name.Name
+		pkgs[k] = append(pkgs[k], filename)
+		return nil
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			if err := add(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if p != path && (d.Name() == "vendor" || d.Name() == "testdata" || strings.HasPrefix(d.Name(), ".")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(p, ".go") {
+				return add(p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pkgs, nil
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"strconv"
+)
+
+// issue is a call site that could not be migrated.
+type issue struct {
+	pos token.Position
+	msg string
+}
+
+func (i issue) String() string {
+	return fmt.Sprintf("%s: %s", i.pos, i.msg)
+}
+
+// migrator rewrites the files of a single package.
+type migrator struct {
+	fset   *token.FileSet
+	info   *types.Info
+	issues []issue
+}
+
+// migratePackage type checks files as one package and rewrites their
+// calls of aws-sdk-go, k8s.io/utils/pointer and k8s.io/utils/ptr
+// helpers. It returns the formatted source of every file that changed,
+// keyed by the file's position in files.
+func migratePackage(fset *token.FileSet, files []*ast.File) (map[int][]byte, []issue, error) {
+	m := &migrator{
+		fset: fset,
+		info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+	}
+	conf := types.Config{
+		Importer: importer.Default(),
+		// The packages being migrated away from are usually not
+		// available; type information is only needed for the
+		// arguments of generic helpers.
+		Error: func(error) {},
+	}
+	_, _ = conf.Check(files[0].Name.Name, fset, files, m.info)
+
+	out := make(map[int][]byte)
+	for i, f := range files {
+		if !m.migrateFile(f) {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, f); err != nil {
+			return nil, nil, err
+		}
+		out[i] = buf.Bytes()
+	}
+	return out, m.issues, nil
+}
+
+func (m *migrator) migrateFile(f *ast.File) bool {
+	imports := make(map[string]string) // import name -> path
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		switch path {
+		case awsPath, k8sPtrPath, k8sPointer:
+			imports[importName(spec)] = path
+		}
+	}
+	if len(imports) == 0 {
+		return false
+	}
+
+	// The pointer package is imported as "pointer" unless that name
+	// stays taken by an import that cannot be removed.
+	pkgName := "pointer"
+	for _, spec := range f.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == pointerPath {
+			pkgName = importName(spec)
+		}
+	}
+
+	type rewrite struct {
+		sel  *ast.SelectorExpr
+		call *ast.CallExpr
+		fn   string
+		drop bool // drop the second argument
+	}
+	var rewrites []rewrite
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fun, targs := unpackIndex(call.Fun)
+		sel, ok := fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		path, ok := m.importPath(sel)
+		if !ok {
+			return true
+		}
+		fn, drop, msg := m.mapCall(path, sel.Sel.Name, targs, call)
+		if msg != "" {
+			m.issues = append(m.issues, issue{pos: m.fset.Position(call.Pos()), msg: msg})
+			return true
+		}
+		if fn != "" {
+			rewrites = append(rewrites, rewrite{sel: sel, call: call, fn: fn, drop: drop})
+		}
+		return true
+	})
+	if len(rewrites) == 0 {
+		return false
+	}
+
+	// Count the references left to each old import once the rewrites
+	// are applied.
+	remaining := make(map[string]int)
+	rewritten := make(map[*ast.SelectorExpr]bool)
+	for _, r := range rewrites {
+		rewritten[r.sel] = true
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok || rewritten[sel] {
+			return true
+		}
+		if _, ok := m.importPath(sel); ok {
+			remaining[sel.X.(*ast.Ident).Name]++
+		}
+		return true
+	})
+	if remaining[pkgName] > 0 {
+		pkgName = "gpointer"
+	}
+
+	for _, r := range rewrites {
+		r.sel.X.(*ast.Ident).Name = pkgName
+		r.sel.Sel.Name = r.fn
+		r.call.Fun = r.sel // drop explicit type arguments
+		if r.drop {
+			r.call.Args = r.call.Args[:1]
+		}
+	}
+	// Add the new import before deleting the old ones so that it takes
+	// the place of the last of them.
+	addImport(m.fset, f, pkgName, pointerPath)
+	for name := range imports {
+		if remaining[name] == 0 {
+			deleteImport(f, name)
+		}
+	}
+	return true
+}
+
+// importPath returns the import path of the package sel refers to, if it
+// is one of the packages being migrated away from.
+func (m *migrator) importPath(sel *ast.SelectorExpr) (string, bool) {
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	pkg, ok := m.info.Uses[x].(*types.PkgName)
+	if !ok {
+		return "", false
+	}
+	switch path := pkg.Imported().Path(); path {
+	case awsPath, k8sPtrPath, k8sPointer:
+		return path, true
+	}
+	return "", false
+}
+
+// mapCall returns the pointer package function replacing the call of fn
+// with the explicit type arguments targs from the package at path, and
+// whether the call's second argument must be dropped. It returns an empty
+// function name for calls that are not pointer helpers, and a message for
+// helpers that cannot be migrated.
+func (m *migrator) mapCall(path, fn string, targs []ast.Expr, call *ast.CallExpr) (string, bool, string) {
+	if len(targs) > 0 && path != k8sPtrPath {
+		// Only the helpers of k8s.io/utils/ptr are generic.
+		return "", false, ""
+	}
+	switch path {
+	case awsPath:
+		if to, ok := awsRules[fn]; ok {
+			return to, false, ""
+		}
+		return "", false, ""
+	case k8sPointer:
+		if to, ok := k8sPointerRules[fn]; ok {
+			return to, false, ""
+		}
+		if to, ok := k8sPointerDerefs[fn]; ok {
+			if len(call.Args) == 2 && isZero(m.info, call.Args[1]) {
+				return to, true, ""
+			}
+			return "", false, fmt.Sprintf("cannot migrate pointer.%s with a non-zero default", fn)
+		}
+		return "", false, fmt.Sprintf("no equivalent for pointer.%s", fn)
+	case k8sPtrPath:
+		switch fn {
+		case "To":
+			var typ types.Type
+			switch {
+			case len(call.Args) != 1:
+			case len(targs) == 0:
+				typ = m.info.TypeOf(call.Args[0])
+			case len(targs) == 1:
+				typ = m.info.TypeOf(targs[0])
+			}
+			if t, ok := typeName(typ); ok {
+				return t + "P", false, ""
+			}
+			return "", false, "cannot migrate ptr.To: argument type unknown or not supported"
+		case "Deref":
+			if len(call.Args) != 2 || !isZero(m.info, call.Args[1]) {
+				return "", false, "cannot migrate ptr.Deref with a non-zero default"
+			}
+			var typ types.Type
+			switch len(targs) {
+			case 0:
+				if ptr, ok := m.info.TypeOf(call.Args[0]).(*types.Pointer); ok {
+					typ = ptr.Elem()
+				}
+			case 1:
+				typ = m.info.TypeOf(targs[0])
+			}
+			if t, ok := typeName(typ); ok {
+				return t, true, ""
+			}
+			return "", false, "cannot migrate ptr.Deref: argument type unknown or not supported"
+		}
+		return "", false, fmt.Sprintf("no equivalent for ptr.%s", fn)
+	}
+	return "", false, ""
+}
+
+// unpackIndex returns the function of an instantiation such as
+// ptr.To[int64] and its type arguments.
+func unpackIndex(e ast.Expr) (ast.Expr, []ast.Expr) {
+	switch e := e.(type) {
+	case *ast.IndexExpr:
+		return e.X, []ast.Expr{e.Index}
+	case *ast.IndexListExpr:
+		return e.X, e.Indices
+	}
+	return e, nil
+}
+
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return path[bytes.LastIndexByte([]byte(path), '/')+1:]
+}
+
+// deleteImport removes the import named name from f.
+func deleteImport(f *ast.File, name string) {
+	for i := 0; i < len(f.Decls); i++ {
+		gen, ok := f.Decls[i].(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		for j := 0; j < len(gen.Specs); j++ {
+			if importName(gen.Specs[j].(*ast.ImportSpec)) == name {
+				gen.Specs = append(gen.Specs[:j], gen.Specs[j+1:]...)
+				j--
+			}
+		}
+		switch len(gen.Specs) {
+		case 0:
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+			i--
+		case 1:
+			gen.Lparen, gen.Rparen = token.NoPos, token.NoPos
+		}
+	}
+	for i := 0; i < len(f.Imports); i++ {
+		if importName(f.Imports[i]) == name {
+			f.Imports = append(f.Imports[:i], f.Imports[i+1:]...)
+			i--
+		}
+	}
+}
+
+// addImport adds an import of path named name to f unless it is already
+// imported.
+func addImport(fset *token.FileSet, f *ast.File, name, path string) {
+	for _, spec := range f.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+			return
+		}
+	}
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+	if name != "pointer" {
+		spec.Name = ast.NewIdent(name)
+	}
+	f.Imports = append(f.Imports, spec)
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		last := gen.Specs[len(gen.Specs)-1]
+		spec.Path.ValuePos = last.End()
+		spec.EndPos = last.End()
+		if !gen.Lparen.IsValid() {
+			gen.Lparen = gen.Specs[0].Pos()
+			gen.Rparen = last.End()
+		}
+		gen.Specs = append(gen.Specs, spec)
+		ast.SortImports(fset, f)
+		return
+	}
+	spec.Path.ValuePos = f.Name.End()
+	gen := &ast.GenDecl{Tok: token.IMPORT, TokPos: f.Name.End(), Specs: []ast.Spec{spec}}
+	f.Decls = append([]ast.Decl{gen}, f.Decls...)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestMigrate(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(input, ".input")
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, input, nil, parser.ParseComments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, issues, err := migratePackage(fset, []*ast.File{f})
+			if err != nil {
+				t.Fatal(err)
+			}
+			src, ok := out[0]
+			if !ok {
+				if src, err = os.ReadFile(input); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var report strings.Builder
+			for _, i := range issues {
+				fmt.Fprintf(&report, "%d:%d: %s\n", i.pos.Line, i.pos.Column, i.msg)
+			}
+			compareGolden(t, name+".golden", string(src))
+			compareGolden(t, name+".issues", report.String())
+		})
+	}
+}
+
+func compareGolden(t *testing.T, filename, got string) {
+	t.Helper()
+	if *update {
+		if got == "" {
+			os.Remove(filename)
+			return
+		}
+		if err := os.WriteFile(filename, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch:\n-- got --\n%s\n-- want --\n%s", filename, got, want)
+	}
+}
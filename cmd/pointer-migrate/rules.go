@@ -0,0 +1,116 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+)
+
+const (
+	pointerPath = "gomodules.xyz/pointer"
+	awsPath     = "github.com/aws/aws-sdk-go/aws"
+	k8sPtrPath  = "k8s.io/utils/ptr"
+	k8sPointer  = "k8s.io/utils/pointer"
+)
+
+// typeNames lists the type prefixes of the helpers of the pointer package.
+var typeNames = []string{
+	"String", "Bool", "Int", "Uint", "Int8", "Int16", "Int32", "Int64",
+	"Uint8", "Uint16", "Uint32", "Uint64", "Float32", "Float64", "Time",
+}
+
+// awsRules maps the functions of aws-sdk-go's convert_types.go, which
+// this package is a fork of, to their equivalents.
+var awsRules = func() map[string]string {
+	m := map[string]string{
+		"SecondsTimeValue":      "SecondsTime",
+		"MillisecondsTimeValue": "MillisecondsTime",
+		"TimeUnixMilli":         "TimeUnixMilli",
+	}
+	for _, t := range typeNames {
+		m[t] = t + "P"
+		m[t+"Value"] = t
+		m[t+"Slice"] = t + "PSlice"
+		m[t+"ValueSlice"] = t + "Slice"
+		m[t+"Map"] = t + "PMap"
+		m[t+"ValueMap"] = t + "Map"
+	}
+	return m
+}()
+
+// k8sPointerTypes lists the types k8s.io/utils/pointer has helpers for.
+var k8sPointerTypes = []string{"String", "Bool", "Int", "Int32", "Int64", "Uint", "Uint32", "Uint64", "Float32", "Float64"}
+
+// k8sPointerRules maps the constructors of k8s.io/utils/pointer.
+var k8sPointerRules = func() map[string]string {
+	m := make(map[string]string)
+	for _, t := range k8sPointerTypes {
+		m[t] = t + "P"
+		m[t+"Ptr"] = t + "P"
+	}
+	return m
+}()
+
+// k8sPointerDerefs maps the dereference helpers of k8s.io/utils/pointer,
+// which are only migrated if their default is the zero value.
+var k8sPointerDerefs = func() map[string]string {
+	m := make(map[string]string)
+	for _, t := range k8sPointerTypes {
+		m[t+"Deref"] = t
+		m[t+"PtrDerefOr"] = t
+	}
+	return m
+}()
+
+var basicTypeNames = map[types.BasicKind]string{
+	types.String:  "String",
+	types.Bool:    "Bool",
+	types.Int:     "Int",
+	types.Int8:    "Int8",
+	types.Int16:   "Int16",
+	types.Int32:   "Int32",
+	types.Int64:   "Int64",
+	types.Uint:    "Uint",
+	types.Uint8:   "Uint8",
+	types.Uint16:  "Uint16",
+	types.Uint32:  "Uint32",
+	types.Uint64:  "Uint64",
+	types.Float32: "Float32",
+	types.Float64: "Float64",
+}
+
+// typeName returns the helper prefix for t, e.g. "String" for string.
+// Untyped constants get their default type.
+func typeName(t types.Type) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	switch t := types.Default(t).(type) {
+	case *types.Basic:
+		name, ok := basicTypeNames[t.Kind()]
+		return name, ok
+	case *types.Named:
+		if obj := t.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return "Time", true
+		}
+	}
+	return "", false
+}
+
+// isZero reports whether e is a constant equal to the zero value of its
+// type.
+func isZero(info *types.Info, e ast.Expr) bool {
+	tv, ok := info.Types[e]
+	if !ok || tv.Value == nil {
+		return false
+	}
+	switch tv.Value.Kind() {
+	case constant.String:
+		return constant.StringVal(tv.Value) == ""
+	case constant.Bool:
+		return !constant.BoolVal(tv.Value)
+	case constant.Int, constant.Float:
+		return constant.Sign(tv.Value) == 0
+	}
+	return false
+}
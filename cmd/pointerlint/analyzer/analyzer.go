@@ -0,0 +1,541 @@
+// Package analyzer defines an analysis.Analyzer that suggests the helpers
+// of gomodules.xyz/pointer in place of hand-rolled pointer idioms and
+// catches misuse of the package.
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"go/version"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// PkgPath is the import path of the pointer package.
+const PkgPath = "gomodules.xyz/pointer"
+
+const doc = `suggest gomodules.xyz/pointer helpers and catch their misuse
+
+The pointerlint analyzer reports:
+
+  - function literals that only take the address of a local copy, such as
+    func() *int { x := 1; return &x }(), which IntP replaces;
+  - variables declared from a constant only to take their address once,
+    such as v := "x"; f(&v), which StringP replaces;
+  - writes through the shared pointers returned by TrueP and FalseP;
+  - loops appending the address of the range variable to a []*T in files
+    before Go 1.22, where every pointer shares one variable. StringPSlice
+    and friends replace them if the ranged slice is not used after the
+    loop, since their pointers point into that slice.`
+
+// Analyzer reports hand-rolled pointer idioms and misuse of the pointer
+// package.
+var Analyzer = &analysis.Analyzer{
+	Name: "pointerlint",
+	Doc:  doc,
+	URL:  "https://pkg.go.dev/gomodules.xyz/pointer/cmd/pointerlint",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == PkgPath {
+		return nil, nil
+	}
+	for _, file := range pass.Files {
+		c := &checker{pass: pass, file: file, iife: make(map[*ast.FuncLit]bool)}
+		c.checkFuncLits()
+		c.checkAddrOfConst()
+		c.checkSharedWrites()
+		c.checkRangeAddr()
+	}
+	return nil, nil
+}
+
+type checker struct {
+	pass *analysis.Pass
+	file *ast.File
+	// iife holds the function literals reported by checkFuncLits, whose
+	// bodies the other checks skip.
+	iife map[*ast.FuncLit]bool
+}
+
+// checkFuncLits reports func() *T { x := v; return &x }().
+func (c *checker) checkFuncLits() {
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 0 {
+			return true
+		}
+		lit, ok := call.Fun.(*ast.FuncLit)
+		if !ok || len(lit.Body.List) != 2 {
+			return true
+		}
+		id, rhs, ok := defineOne(lit.Body.List[0])
+		if !ok {
+			return true
+		}
+		ret, ok := lit.Body.List[1].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 || !isAddrOf(c.pass, ret.Results[0], c.pass.TypesInfo.Defs[id]) {
+			return true
+		}
+		fn, ok := helperName(c.pass.TypesInfo.Defs[id].Type())
+		if !ok {
+			return true
+		}
+		c.iife[lit] = true
+		c.report(call, fn+"P", fmt.Sprintf("function literal returning the address of a local copy can be replaced by pointer.%sP", fn), rhs)
+		return true
+	})
+}
+
+// checkAddrOfConst reports v := <const>; ... &v where &v is the only use
+// of v.
+func (c *checker) checkAddrOfConst() {
+	for _, decl := range c.file.Decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.FuncLit); ok && c.iife[lit] {
+				return false
+			}
+			block, ok := n.(*ast.BlockStmt)
+			if !ok {
+				return true
+			}
+			for _, stmt := range block.List {
+				id, rhs, ok := defineOne(stmt)
+				if !ok {
+					continue
+				}
+				if tv, ok := c.pass.TypesInfo.Types[rhs]; !ok || tv.Value == nil {
+					continue
+				}
+				obj := c.pass.TypesInfo.Defs[id]
+				fn, ok := helperName(obj.Type())
+				if !ok {
+					continue
+				}
+				addr := c.onlyAddrUse(block, obj)
+				if addr == nil {
+					continue
+				}
+				c.report(addr, fn+"P",
+					fmt.Sprintf("variable %s is only declared to take its address; use pointer.%sP", id.Name, fn),
+					rhs, c.deleteLine(stmt))
+			}
+			return true
+		})
+	}
+}
+
+// onlyAddrUse returns the &obj expression if it is the only use of obj
+// within block. Uses inside loops and function literals are not
+// reported, since replacing them would allocate a new pointer on every
+// iteration or call.
+func (c *checker) onlyAddrUse(block *ast.BlockStmt, obj types.Object) *ast.UnaryExpr {
+	var addr *ast.UnaryExpr
+	var stack []ast.Node
+	uses, nested := 0, 0
+	ast.Inspect(block, func(n ast.Node) bool {
+		if n == nil {
+			switch stack[len(stack)-1].(type) {
+			case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+				nested--
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			nested++
+		case *ast.UnaryExpr:
+			if nested == 0 && isAddrOf(c.pass, n, obj) {
+				addr = n
+			}
+		case *ast.Ident:
+			if c.pass.TypesInfo.Uses[n] == obj {
+				uses++
+			}
+		}
+		return true
+	})
+	if uses != 1 {
+		return nil
+	}
+	return addr
+}
+
+// deleteLine returns an edit removing stmt along with its line.
+func (c *checker) deleteLine(stmt ast.Stmt) analysis.TextEdit {
+	tf := c.pass.Fset.File(stmt.Pos())
+	end := tf.Line(stmt.End())
+	if end >= tf.LineCount() {
+		return analysis.TextEdit{Pos: stmt.Pos(), End: stmt.End()}
+	}
+	return analysis.TextEdit{Pos: tf.LineStart(tf.Line(stmt.Pos())), End: tf.LineStart(end + 1)}
+}
+
+// checkSharedWrites reports assignments through the pointers returned by
+// TrueP and FalseP, either directly or through a variable initialized
+// from them.
+func (c *checker) checkSharedWrites() {
+	shared := make(map[types.Object]*ast.CallExpr)
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == len(n.Rhs) {
+				for i, rhs := range n.Rhs {
+					id, ok := n.Lhs[i].(*ast.Ident)
+					if !ok {
+						continue
+					}
+					obj := c.pass.TypesInfo.ObjectOf(id)
+					if call, ok := c.sharedCall(rhs); ok && n.Tok == token.DEFINE {
+						shared[obj] = call
+					} else {
+						delete(shared, obj)
+					}
+				}
+			}
+			for _, lhs := range n.Lhs {
+				c.checkSharedWrite(lhs, shared)
+			}
+		case *ast.IncDecStmt:
+			c.checkSharedWrite(n.X, shared)
+		}
+		return true
+	})
+}
+
+func (c *checker) checkSharedWrite(lhs ast.Expr, shared map[types.Object]*ast.CallExpr) {
+	star, ok := ast.Unparen(lhs).(*ast.StarExpr)
+	if !ok {
+		return
+	}
+	x := ast.Unparen(star.X)
+	if call, ok := c.sharedCall(x); ok {
+		c.pass.Reportf(star.Pos(), "write through the shared pointer returned by pointer.%s changes it for every caller; use pointer.BoolP", c.calleeName(call))
+		return
+	}
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return
+	}
+	call, ok := shared[c.pass.TypesInfo.Uses[id]]
+	if !ok {
+		return
+	}
+	name := c.calleeName(call)
+	val := "true"
+	if name == "FalseP" {
+		val = "false"
+	}
+	pkg, importEdits := c.pointerImport()
+	c.pass.Report(analysis.Diagnostic{
+		Pos:     star.Pos(),
+		End:     star.End(),
+		Message: fmt.Sprintf("write through the shared pointer returned by pointer.%s changes it for every caller; use pointer.BoolP", name),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: fmt.Sprintf("Replace pointer.%s() with pointer.BoolP(%s)", name, val),
+			TextEdits: append(importEdits, analysis.TextEdit{
+				Pos:     call.Pos(),
+				End:     call.End(),
+				NewText: []byte(pkg + ".BoolP(" + val + ")"),
+			}),
+		}},
+	})
+}
+
+// sharedCall reports whether e is a call of pointer.TrueP or
+// pointer.FalseP.
+func (c *checker) sharedCall(e ast.Expr) (*ast.CallExpr, bool) {
+	call, ok := ast.Unparen(e).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	name := c.calleeName(call)
+	return call, name == "TrueP" || name == "FalseP"
+}
+
+// calleeName returns the name of the pointer package function called by
+// call, or "".
+func (c *checker) calleeName(call *ast.CallExpr) string {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := c.pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != PkgPath {
+		return ""
+	}
+	return fn.Name()
+}
+
+// checkRangeAddr reports loops that append the address of the range
+// value to a []*T. Since Go 1.22 each iteration has its own range
+// variable, so only files of older or unknown versions are checked.
+func (c *checker) checkRangeAddr() {
+	v := c.pass.TypesInfo.FileVersions[c.file]
+	if v == "" {
+		v = c.pass.Pkg.GoVersion()
+	}
+	if v == "" || version.Compare(v, "go1.22") >= 0 {
+		return
+	}
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		rng, ok := n.(*ast.RangeStmt)
+		if !ok || rng.Value == nil {
+			return true
+		}
+		val, ok := rng.Value.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.pass.TypesInfo.Defs[val]
+		if obj == nil {
+			return true
+		}
+		slice, ok := c.pass.TypesInfo.TypeOf(rng.X).Underlying().(*types.Slice)
+		if !ok {
+			return true
+		}
+		fn, ok := helperName(slice.Elem())
+		if !ok {
+			return true
+		}
+		ast.Inspect(rng.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !isBuiltin(c.pass, call.Fun, "append") {
+				return true
+			}
+			for _, arg := range call.Args[1:] {
+				if !isAddrOf(c.pass, arg, obj) {
+					continue
+				}
+				msg := fmt.Sprintf("appending the address of range variable %s; use pointer.%sPSlice", val.Name, fn)
+				if fix, ok := c.rangeFix(rng, call, fn); ok {
+					c.pass.Report(analysis.Diagnostic{Pos: arg.Pos(), End: arg.End(), Message: msg, SuggestedFixes: fix})
+				} else {
+					c.pass.Reportf(arg.Pos(), "%s", msg)
+				}
+			}
+			return true
+		})
+		return true
+	})
+}
+
+// rangeFix returns a fix replacing
+//
+//	for _, v := range src { dst = append(dst, &v) }
+//
+// by dst = append(dst, pointer.TPSlice(src)...). The new pointers point
+// into src instead of to copies, so the fix is only offered if src is a
+// variable declared in the body of the function containing the loop, not
+// a parameter, and is not used after the loop.
+func (c *checker) rangeFix(rng *ast.RangeStmt, call *ast.CallExpr, fn string) ([]analysis.SuggestedFix, bool) {
+	if !c.unusedAfter(rng.X, rng.End()) {
+		return nil, false
+	}
+	if rng.Key != nil {
+		if id, ok := rng.Key.(*ast.Ident); !ok || id.Name != "_" {
+			return nil, false
+		}
+	}
+	if len(rng.Body.List) != 1 || len(call.Args) != 2 {
+		return nil, false
+	}
+	assign, ok := rng.Body.List[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || assign.Rhs[0] != ast.Expr(call) {
+		return nil, false
+	}
+	if c.render(assign.Lhs[0]) != c.render(call.Args[0]) {
+		return nil, false
+	}
+	pkg, importEdits := c.pointerImport()
+	text := fmt.Sprintf("%s = append(%s, %s.%sPSlice(%s)...)", c.render(assign.Lhs[0]), c.render(call.Args[0]), pkg, fn, c.render(rng.X))
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Replace loop with pointer.%sPSlice, whose pointers point into %s", fn, c.render(rng.X)),
+		TextEdits: append(importEdits, analysis.TextEdit{Pos: rng.Pos(), End: rng.End(), NewText: []byte(text)}),
+	}}, true
+}
+
+// unusedAfter reports whether e is a variable declared in the body of
+// the innermost function containing pos and is not used after pos.
+// Parameters, results and variables captured from enclosing functions
+// may be used by the caller, so they are never unused.
+func (c *checker) unusedAfter(e ast.Expr, pos token.Pos) bool {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj, ok := c.pass.TypesInfo.Uses[id].(*types.Var)
+	if !ok {
+		return false
+	}
+	body := c.funcBody(pos)
+	if body == nil || obj.Pos() < body.Pos() || obj.Pos() >= body.End() {
+		return false
+	}
+	unused := true
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Pos() > pos && c.pass.TypesInfo.Uses[id] == obj {
+			unused = false
+		}
+		return unused
+	})
+	return unused
+}
+
+// funcBody returns the body of the innermost function containing pos.
+func (c *checker) funcBody(pos token.Pos) *ast.BlockStmt {
+	var body *ast.BlockStmt
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			body = n.Body
+		case *ast.FuncLit:
+			body = n.Body
+		}
+		return true
+	})
+	return body
+}
+
+// report reports node with a fix replacing it by a call of the pointer
+// package function fn with arg, plus any extra edits.
+func (c *checker) report(node ast.Node, fn, msg string, arg ast.Expr, extra ...analysis.TextEdit) {
+	pkg, importEdits := c.pointerImport()
+	edits := append(importEdits, extra...)
+	edits = append(edits, analysis.TextEdit{
+		Pos:     node.Pos(),
+		End:     node.End(),
+		NewText: []byte(fmt.Sprintf("%s.%s(%s)", pkg, fn, c.render(arg))),
+	})
+	c.pass.Report(analysis.Diagnostic{
+		Pos:     node.Pos(),
+		End:     node.End(),
+		Message: msg,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Replace with pointer." + fn,
+			TextEdits: edits,
+		}},
+	})
+}
+
+// pointerImport returns the name the file uses for the pointer package
+// and the edits needed to import it if it does not yet.
+func (c *checker) pointerImport() (string, []analysis.TextEdit) {
+	for _, spec := range c.file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == PkgPath {
+			if spec.Name != nil {
+				return spec.Name.Name, nil
+			}
+			return "pointer", nil
+		}
+	}
+	for _, decl := range c.file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			return "pointer", []analysis.TextEdit{{Pos: gen.Rparen, End: gen.Rparen, NewText: []byte("\t" + strconv.Quote(PkgPath) + "\n")}}
+		}
+		return "pointer", []analysis.TextEdit{{Pos: gen.Pos(), End: gen.Pos(), NewText: []byte("import " + strconv.Quote(PkgPath) + "\n")}}
+	}
+	return "pointer", []analysis.TextEdit{{Pos: c.file.Name.End(), End: c.file.Name.End(), NewText: []byte("\n\nimport " + strconv.Quote(PkgPath))}}
+}
+
+func (c *checker) render(n ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, c.pass.Fset, n); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// defineOne matches x := v and var x = v declaring a single variable.
+func defineOne(stmt ast.Stmt) (*ast.Ident, ast.Expr, bool) {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil, nil, false
+		}
+		id, ok := stmt.Lhs[0].(*ast.Ident)
+		if !ok || id.Name == "_" {
+			return nil, nil, false
+		}
+		return id, stmt.Rhs[0], true
+	case *ast.DeclStmt:
+		gen, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR || len(gen.Specs) != 1 {
+			return nil, nil, false
+		}
+		spec := gen.Specs[0].(*ast.ValueSpec)
+		if len(spec.Names) != 1 || len(spec.Values) != 1 || spec.Names[0].Name == "_" {
+			return nil, nil, false
+		}
+		return spec.Names[0], spec.Values[0], true
+	}
+	return nil, nil, false
+}
+
+// isAddrOf reports whether e is &x for the variable obj.
+func isAddrOf(pass *analysis.Pass, e ast.Expr, obj types.Object) bool {
+	u, ok := ast.Unparen(e).(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return false
+	}
+	id, ok := ast.Unparen(u.X).(*ast.Ident)
+	return ok && obj != nil && pass.TypesInfo.Uses[id] == obj
+}
+
+func isBuiltin(pass *analysis.Pass, e ast.Expr, name string) bool {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	b, ok := pass.TypesInfo.Uses[id].(*types.Builtin)
+	return ok && b.Name() == name
+}
+
+var basicHelpers = map[types.BasicKind]string{
+	types.String:  "String",
+	types.Bool:    "Bool",
+	types.Int:     "Int",
+	types.Int8:    "Int8",
+	types.Int16:   "Int16",
+	types.Int32:   "Int32",
+	types.Int64:   "Int64",
+	types.Uint:    "Uint",
+	types.Uint8:   "Uint8",
+	types.Uint16:  "Uint16",
+	types.Uint32:  "Uint32",
+	types.Uint64:  "Uint64",
+	types.Float32: "Float32",
+	types.Float64: "Float64",
+}
+
+// helperName returns the type prefix of the pointer package helpers for
+// t, e.g. "String" for string, or false if the package has none.
+func helperName(t types.Type) (string, bool) {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		name, ok := basicHelpers[t.Kind()]
+		return name, ok
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return "Time", true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,42 @@
+package a
+
+import (
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	Name     *string
+	Replicas *int
+	Limit    *int64
+	Since    *time.Time
+}
+
+func iife() Config {
+	return Config{
+		Name:     func() *string { s := "app"; return &s }(),         // want `function literal returning the address of a local copy can be replaced by pointer.StringP`
+		Replicas: func() *int { var n = 3; return &n }(),             // want `function literal returning the address of a local copy can be replaced by pointer.IntP`
+		Since:    func() *time.Time { t := time.Now(); return &t }(), // want `function literal returning the address of a local copy can be replaced by pointer.TimeP`
+	}
+}
+
+func addrOfConst() {
+	limit := int64(10)
+	use(&limit) // want `variable limit is only declared to take its address; use pointer.Int64P`
+
+	name := "x"
+	use(&name)
+	fmt.Println(name)
+
+	for i := 0; i < 3; i++ {
+		n := 1
+		use(&n) // want `variable n is only declared to take its address; use pointer.IntP`
+	}
+
+	shared := "s"
+	for i := 0; i < 3; i++ {
+		use(&shared)
+	}
+}
+
+func use(v interface{}) {}
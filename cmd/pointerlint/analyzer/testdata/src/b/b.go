@@ -0,0 +1,21 @@
+package b
+
+import p "gomodules.xyz/pointer"
+
+func shared() {
+	*p.TrueP() = false // want `write through the shared pointer returned by pointer.TrueP changes it for every caller; use pointer.BoolP`
+
+	enabled := p.FalseP()
+	*enabled = true // want `write through the shared pointer returned by pointer.FalseP changes it for every caller; use pointer.BoolP`
+
+	other := p.TrueP()
+	other = p.BoolP(true)
+	*other = false
+
+	flag := p.TrueP()
+	_ = *flag
+}
+
+func alias() *string {
+	return func() *string { s := "b"; return &s }() // want `function literal returning the address of a local copy can be replaced by pointer.StringP`
+}
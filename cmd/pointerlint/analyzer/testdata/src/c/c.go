@@ -0,0 +1,5 @@
+package c
+
+func ratio() *float64 {
+	return func() *float64 { r := 0.5; return &r }() // want `function literal returning the address of a local copy can be replaced by pointer.Float64P`
+}
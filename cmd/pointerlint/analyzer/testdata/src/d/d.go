@@ -0,0 +1,59 @@
+//go:build go1.21
+
+package d
+
+var global []int
+
+func rangeAddr(src []int64, names []string) []*int64 {
+	var dst []*int64
+	for _, v := range src {
+		dst = append(dst, &v) // want `appending the address of range variable v; use pointer.Int64PSlice`
+	}
+	var out []*string
+	for i, v := range names {
+		if i > 0 {
+			out = append(out, &v) // want `appending the address of range variable v; use pointer.StringPSlice`
+		}
+	}
+	use(out)
+	return dst
+}
+
+func localSrc() []*int64 {
+	src := []int64{1, 2}
+	var dst []*int64
+	for _, v := range src {
+		dst = append(dst, &v) // want `appending the address of range variable v; use pointer.Int64PSlice`
+	}
+	return dst
+}
+
+func capturedSrc() func() []*int64 {
+	src := []int64{1, 2}
+	return func() []*int64 {
+		var dst []*int64
+		for _, v := range src {
+			dst = append(dst, &v) // want `appending the address of range variable v; use pointer.Int64PSlice`
+		}
+		return dst
+	}
+}
+
+func usedAfter(src []string) []*string {
+	var dst []*string
+	for _, v := range src {
+		dst = append(dst, &v) // want `appending the address of range variable v; use pointer.StringPSlice`
+	}
+	src[0] = ""
+	return dst
+}
+
+func globalSrc() []*int {
+	var dst []*int
+	for _, v := range global {
+		dst = append(dst, &v) // want `appending the address of range variable v; use pointer.IntPSlice`
+	}
+	return dst
+}
+
+func use(v interface{}) {}
@@ -0,0 +1,12 @@
+//go:build go1.22
+
+package d
+
+// Each iteration has its own v since Go 1.22, so this is not reported.
+func perIteration(src []int64) []*int64 {
+	var dst []*int64
+	for _, v := range src {
+		dst = append(dst, &v)
+	}
+	return dst
+}
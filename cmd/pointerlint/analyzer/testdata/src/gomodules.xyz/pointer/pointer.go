@@ -0,0 +1,29 @@
+package pointer
+
+import "time"
+
+func StringP(v string) *string { return &v }
+
+func StringPSlice(src []string) []*string {
+	dst := make([]*string, len(src))
+	for i := range src {
+		dst[i] = &src[i]
+	}
+	return dst
+}
+
+func BoolP(v bool) *bool { return &v }
+
+var trueP, falseP = BoolP(true), BoolP(false)
+
+func TrueP() *bool { return trueP }
+
+func FalseP() *bool { return falseP }
+
+func IntP(v int) *int { return &v }
+
+func Int64P(v int64) *int64 { return &v }
+
+func Int64PSlice(src []int64) []*int64 { return nil }
+
+func TimeP(v time.Time) *time.Time { return &v }
@@ -0,0 +1,16 @@
+// Command pointerlint reports code that can use the helpers of
+// gomodules.xyz/pointer and misuse of the package. It can be run on its
+// own or by go vet:
+//
+//	go vet -vettool=$(which pointerlint) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"gomodules.xyz/pointer/cmd/pointerlint/analyzer"
+)
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
@@ -0,0 +1,133 @@
+package pointer
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ChangeKind describes how a pointer field changed.
+type ChangeKind int
+
+const (
+	// FieldSet means the pointer went from nil to non-nil.
+	FieldSet ChangeKind = iota + 1
+	// FieldCleared means the pointer went from non-nil to nil.
+	FieldCleared
+	// FieldChanged means both pointers are non-nil but point to
+	// different values.
+	FieldChanged
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case FieldSet:
+		return "set"
+	case FieldCleared:
+		return "cleared"
+	case FieldChanged:
+		return "changed"
+	}
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// FieldChange describes a change of a single pointer field.
+type FieldChange struct {
+	// Path is the dot separated path of Go field names leading to the
+	// field, e.g. "Spec.Replicas".
+	Path string
+	Kind ChangeKind
+	// Old and New hold the values the pointers point to, or nil if the
+	// pointer is nil.
+	Old, New interface{}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// DiffPointers compares the pointer fields of two structs of the same type
+// and returns a change for every field that was set, cleared or changed.
+// Nested structs, and pointers to structs that are non-nil on both sides,
+// are walked recursively. Values are compared with reflect.DeepEqual,
+// except time.Time values which are compared with time.Time.Equal.
+// Non-pointer fields other than structs are ignored.
+//
+// Pointers to structs that refer back to a pair of structs already being
+// compared are not walked again, so cyclic structures are supported.
+//
+// old and new must be structs or pointers to structs of the same type;
+// any other type, including nil, will cause a panic.
+func DiffPointers(old, new interface{}) []FieldChange {
+	o, n := reflect.ValueOf(old), reflect.ValueOf(new)
+	if !o.IsValid() || !n.IsValid() {
+		panic(fmt.Sprintf("pointer: DiffPointers called with nil argument %T and %T", old, new))
+	}
+	if o.Type() != n.Type() {
+		panic(fmt.Sprintf("pointer: DiffPointers called with different types %s and %s", o.Type(), n.Type()))
+	}
+	d := differ{visiting: make(map[[2]uintptr]bool)}
+	if o.Kind() == reflect.Ptr {
+		if o.Type().Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("pointer: DiffPointers called with non-struct type %s", o.Type()))
+		}
+		d.diffPointer("", o, n)
+		return d.changes
+	}
+	if o.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("pointer: DiffPointers called with non-struct type %s", o.Type()))
+	}
+	d.diffStruct("", o, n)
+	return d.changes
+}
+
+type differ struct {
+	changes []FieldChange
+	// visiting holds the pairs of struct pointers being compared.
+	visiting map[[2]uintptr]bool
+}
+
+func (d *differ) diffStruct(path string, o, n reflect.Value) {
+	t := o.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		p := f.Name
+		if path != "" {
+			p = path + "." + f.Name
+		}
+		switch {
+		case f.Type.Kind() == reflect.Ptr:
+			d.diffPointer(p, o.Field(i), n.Field(i))
+		case f.Type.Kind() == reflect.Struct && f.Type != timeType:
+			d.diffStruct(p, o.Field(i), n.Field(i))
+		}
+	}
+}
+
+func (d *differ) diffPointer(path string, o, n reflect.Value) {
+	switch {
+	case o.IsNil() && n.IsNil():
+	case o.IsNil():
+		d.changes = append(d.changes, FieldChange{Path: path, Kind: FieldSet, New: n.Elem().Interface()})
+	case n.IsNil():
+		d.changes = append(d.changes, FieldChange{Path: path, Kind: FieldCleared, Old: o.Elem().Interface()})
+	case o.Type().Elem().Kind() == reflect.Struct && o.Type().Elem() != timeType:
+		key := [2]uintptr{o.Pointer(), n.Pointer()}
+		if d.visiting[key] {
+			return
+		}
+		d.visiting[key] = true
+		d.diffStruct(path, o.Elem(), n.Elem())
+		delete(d.visiting, key)
+	case !equalValue(o.Elem(), n.Elem()):
+		d.changes = append(d.changes, FieldChange{Path: path, Kind: FieldChanged, Old: o.Elem().Interface(), New: n.Elem().Interface()})
+	}
+}
+
+func equalValue(a, b reflect.Value) bool {
+	if a.Type() == timeType {
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
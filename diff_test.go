@@ -0,0 +1,111 @@
+package pointer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type diffTestSecurityContext struct {
+	RunAsUser *int64
+	Sysctls   map[string]string
+}
+
+type diffTestSpec struct {
+	Replicas        *int32
+	Paused          *bool
+	SecurityContext *diffTestSecurityContext
+}
+
+type diffTestObject struct {
+	Name      string
+	Spec      diffTestSpec
+	Deadline  *time.Time
+	Labels    *map[string]string
+	unexposed *string
+}
+
+func TestDiffPointers(t *testing.T) {
+	now := time.Now()
+	old := diffTestObject{
+		Name: "a",
+		Spec: diffTestSpec{
+			Replicas:        Int32P(1),
+			Paused:          FalseP(),
+			SecurityContext: &diffTestSecurityContext{RunAsUser: Int64P(1000)},
+		},
+		Deadline:  TimeP(now),
+		unexposed: StringP("a"),
+	}
+	new := diffTestObject{
+		Name: "b",
+		Spec: diffTestSpec{
+			Replicas:        Int32P(3),
+			SecurityContext: &diffTestSecurityContext{RunAsUser: Int64P(1000)},
+		},
+		Deadline: TimeP(now.UTC()),
+		Labels:   &map[string]string{"a": "b"},
+	}
+	expected := []FieldChange{
+		{Path: "Spec.Replicas", Kind: FieldChanged, Old: int32(1), New: int32(3)},
+		{Path: "Spec.Paused", Kind: FieldCleared, Old: false},
+		{Path: "Labels", Kind: FieldSet, New: map[string]string{"a": "b"}},
+	}
+	if e, a := expected, DiffPointers(old, new); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %+v, got %+v", e, a)
+	}
+	if a := DiffPointers(&old, &old); len(a) != 0 {
+		t.Errorf("Expected no changes, got %+v", a)
+	}
+
+	new.Spec.SecurityContext = nil
+	expected = []FieldChange{
+		{Path: "Spec.Replicas", Kind: FieldChanged, Old: int32(1), New: int32(3)},
+		{Path: "Spec.Paused", Kind: FieldCleared, Old: false},
+		{Path: "Spec.SecurityContext", Kind: FieldCleared, Old: diffTestSecurityContext{RunAsUser: Int64P(1000)}},
+		{Path: "Labels", Kind: FieldSet, New: map[string]string{"a": "b"}},
+	}
+	if e, a := expected, DiffPointers(&old, &new); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %+v, got %+v", e, a)
+	}
+}
+
+type diffTestNode struct {
+	Value *int
+	Next  *diffTestNode
+}
+
+func TestDiffPointersCycle(t *testing.T) {
+	old := &diffTestNode{Value: IntP(1)}
+	old.Next = &diffTestNode{Value: IntP(2), Next: old}
+	new := &diffTestNode{Value: IntP(1)}
+	new.Next = &diffTestNode{Value: IntP(3), Next: new}
+	expected := []FieldChange{
+		{Path: "Next.Value", Kind: FieldChanged, Old: 2, New: 3},
+	}
+	if e, a := expected, DiffPointers(old, new); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %+v, got %+v", e, a)
+	}
+	if a := DiffPointers(old, old); len(a) != 0 {
+		t.Errorf("Expected no changes, got %+v", a)
+	}
+}
+
+var testCasesDiffPointersPanics = []struct {
+	old, new interface{}
+	expected string
+}{
+	{diffTestSpec{}, diffTestObject{}, "pointer: DiffPointers called with different types pointer.diffTestSpec and pointer.diffTestObject"},
+	{nil, nil, "pointer: DiffPointers called with nil argument <nil> and <nil>"},
+	{nil, &diffTestSpec{}, "pointer: DiffPointers called with nil argument <nil> and *pointer.diffTestSpec"},
+	{1, 2, "pointer: DiffPointers called with non-struct type int"},
+	{IntP(1), IntP(2), "pointer: DiffPointers called with non-struct type *int"},
+}
+
+func TestDiffPointersPanics(t *testing.T) {
+	for idx, c := range testCasesDiffPointersPanics {
+		if e, a := c.expected, recoverMessage(func() { DiffPointers(c.old, c.new) }); e != a {
+			t.Errorf("Unexpected panic at idx %d, expected %v, got %v", idx, e, a)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Package env loads optional configuration values from environment
+// variables into pointer fields.
+//
+//	type Config struct {
+//		Name    *string        `env:"APP_NAME"`
+//		Port    *int           `env:"APP_PORT"`
+//		Timeout *time.Duration `env:"APP_TIMEOUT"`
+//		Since   *time.Time     `env:"APP_SINCE" layout:"2006-01-02"`
+//	}
+//
+// A field whose variable is unset is left untouched, so it stays nil
+// unless it was set before calling Load.
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gomodules.xyz/pointer"
+)
+
+// DefaultTimeLayout is the layout used to parse *time.Time fields without
+// a layout tag.
+const DefaultTimeLayout = time.RFC3339
+
+// Load sets the pointer fields of the struct pointed to by v from the
+// environment variables named by their env tags. Nested structs and
+// pointers to structs without an env tag are loaded recursively; a nil
+// pointer to a struct is only allocated if one of its variables is set,
+// and never for a struct type that is already being loaded.
+//
+// Supported field types are *string, *bool, *int, *int8, *int16, *int32,
+// *int64, *uint, *uint8, *uint16, *uint32, *uint64, *float32, *float64,
+// *time.Duration and *time.Time. Load reports every variable that failed
+// to parse, joined into a single error.
+func Load(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("env: Load called with non-struct pointer type %T", v)
+	}
+	l := loader{types: make(map[reflect.Type]bool), ptrs: make(map[ptrKey]bool)}
+	l.ptrs[keyOf(rv)] = true
+	_, err := l.load(rv.Elem())
+	return err
+}
+
+// loader holds the struct types and pointers being loaded, which are not
+// entered again, so self-referential types like
+//
+//	type Config struct {
+//		Fallback *Config
+//	}
+//
+// do not recurse forever.
+type loader struct {
+	types map[reflect.Type]bool
+	ptrs  map[ptrKey]bool
+}
+
+// ptrKey identifies a pointer by address and type, since a struct and its
+// first field share an address.
+type ptrKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func keyOf(v reflect.Value) ptrKey {
+	return ptrKey{v.Pointer(), v.Type()}
+}
+
+// load sets the fields of the struct v and reports whether any variable
+// was set.
+func (l loader) load(v reflect.Value) (bool, error) {
+	t := v.Type()
+	l.types[t] = true
+	defer delete(l.types, t)
+	var errs []error
+	set := false
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		name, ok := f.Tag.Lookup("env")
+		if !ok || name == "" {
+			var fset bool
+			var err error
+			switch {
+			case f.Type.Kind() == reflect.Struct && f.Type != timeType:
+				fset, err = l.load(fv)
+			case f.Type.Kind() == reflect.Ptr && f.Type.Elem().Kind() == reflect.Struct && f.Type.Elem() != timeType:
+				switch {
+				case fv.IsNil():
+					if l.types[f.Type.Elem()] {
+						break
+					}
+					nv := reflect.New(f.Type.Elem())
+					if fset, err = l.load(nv.Elem()); fset {
+						fv.Set(nv)
+					}
+				case !l.ptrs[keyOf(fv)]:
+					l.ptrs[keyOf(fv)] = true
+					fset, err = l.load(fv.Elem())
+					delete(l.ptrs, keyOf(fv))
+				}
+			}
+			set = set || fset
+			if err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		s, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := parse(fv, s, f.Tag.Get("layout")); err != nil {
+			errs = append(errs, fmt.Errorf("env: %s: %w", name, err))
+			continue
+		}
+		set = true
+	}
+	return set, errors.Join(errs...)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func parse(fv reflect.Value, s, layout string) error {
+	switch p := fv.Addr().Interface().(type) {
+	case **string:
+		*p = pointer.StringP(s)
+	case **bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		*p = pointer.BoolP(v)
+	case **int:
+		v, err := strconv.ParseInt(s, 0, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		*p = pointer.IntP(int(v))
+	case **int8:
+		v, err := strconv.ParseInt(s, 0, 8)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Int8P(int8(v))
+	case **int16:
+		v, err := strconv.ParseInt(s, 0, 16)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Int16P(int16(v))
+	case **int32:
+		v, err := strconv.ParseInt(s, 0, 32)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Int32P(int32(v))
+	case **int64:
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Int64P(v)
+	case **uint:
+		v, err := strconv.ParseUint(s, 0, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		*p = pointer.UintP(uint(v))
+	case **uint8:
+		v, err := strconv.ParseUint(s, 0, 8)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Uint8P(uint8(v))
+	case **uint16:
+		v, err := strconv.ParseUint(s, 0, 16)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Uint16P(uint16(v))
+	case **uint32:
+		v, err := strconv.ParseUint(s, 0, 32)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Uint32P(uint32(v))
+	case **uint64:
+		v, err := strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Uint64P(v)
+	case **float32:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Float32P(float32(v))
+	case **float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		*p = pointer.Float64P(v)
+	case **time.Duration:
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*p = &v
+	case **time.Time:
+		if layout == "" {
+			layout = DefaultTimeLayout
+		}
+		v, err := time.Parse(layout, s)
+		if err != nil {
+			return err
+		}
+		*p = pointer.TimeP(v)
+	default:
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package env
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"gomodules.xyz/pointer"
+)
+
+type testDatabase struct {
+	Host *string `env:"TEST_DB_HOST"`
+	Port *uint16 `env:"TEST_DB_PORT"`
+}
+
+type testConfig struct {
+	Name     *string        `env:"TEST_NAME"`
+	Debug    *bool          `env:"TEST_DEBUG"`
+	Workers  *int           `env:"TEST_WORKERS"`
+	Level    *int8          `env:"TEST_LEVEL"`
+	Retries  *int16         `env:"TEST_RETRIES"`
+	Replicas *int32         `env:"TEST_REPLICAS"`
+	Limit    *int64         `env:"TEST_LIMIT"`
+	Size     *uint          `env:"TEST_SIZE"`
+	Mask     *uint8         `env:"TEST_MASK"`
+	Quota    *uint32        `env:"TEST_QUOTA"`
+	Bytes    *uint64        `env:"TEST_BYTES"`
+	Ratio    *float32       `env:"TEST_RATIO"`
+	Weight   *float64       `env:"TEST_WEIGHT"`
+	Timeout  *time.Duration `env:"TEST_TIMEOUT"`
+	Since    *time.Time     `env:"TEST_SINCE"`
+	Day      *time.Time     `env:"TEST_DAY" layout:"2006-01-02"`
+	Unset    *string        `env:"TEST_UNSET"`
+	Untagged *string
+	DB       testDatabase
+	Cache    *testDatabase
+}
+
+func TestLoad(t *testing.T) {
+	env := map[string]string{
+		"TEST_NAME":     "app",
+		"TEST_DEBUG":    "true",
+		"TEST_WORKERS":  "0",
+		"TEST_LEVEL":    "-3",
+		"TEST_RETRIES":  "5",
+		"TEST_REPLICAS": "3",
+		"TEST_LIMIT":    "0x10",
+		"TEST_SIZE":     "7",
+		"TEST_MASK":     "255",
+		"TEST_QUOTA":    "100",
+		"TEST_BYTES":    "1024",
+		"TEST_RATIO":    "0.5",
+		"TEST_WEIGHT":   "1.25",
+		"TEST_TIMEOUT":  "1m30s",
+		"TEST_SINCE":    "2021-03-04T05:06:07Z",
+		"TEST_DAY":      "2021-03-04",
+		"TEST_DB_HOST":  "localhost",
+	}
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+
+	var cfg testConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := "app", pointer.String(cfg.Name); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if !pointer.Bool(cfg.Debug) {
+		t.Errorf("Expected Debug to be true")
+	}
+	if cfg.Workers == nil || *cfg.Workers != 0 {
+		t.Errorf("Expected Workers to be set to 0")
+	}
+	if e, a := int8(-3), pointer.Int8(cfg.Level); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := int64(16), pointer.Int64(cfg.Limit); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := uint8(255), pointer.Uint8(cfg.Mask); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := float32(0.5), pointer.Float32(cfg.Ratio); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := 90*time.Second, *cfg.Timeout; e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), pointer.Time(cfg.Since); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), pointer.Time(cfg.Day); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if cfg.Unset != nil || cfg.Untagged != nil || cfg.DB.Port != nil {
+		t.Errorf("Expected unset variables to leave fields nil")
+	}
+	if e, a := "localhost", pointer.String(cfg.DB.Host); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if cfg.Cache == nil {
+		t.Errorf("Expected nested struct pointer to be allocated")
+	}
+}
+
+func TestLoadLeavesNestedPointerNil(t *testing.T) {
+	var cfg struct {
+		DB *testDatabase
+	}
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.DB != nil {
+		t.Errorf("Expected nested struct pointer to stay nil")
+	}
+}
+
+type testRecursiveConfig struct {
+	Name     *string `env:"TEST_RECURSIVE_NAME"`
+	Fallback *testRecursiveConfig
+	Peer     *testRecursiveConfig
+}
+
+func TestLoadRecursiveType(t *testing.T) {
+	t.Setenv("TEST_RECURSIVE_NAME", "a")
+	var cfg testRecursiveConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pointer.String(cfg.Name) != "a" || cfg.Fallback != nil {
+		t.Errorf("Expected only the outer config to be loaded, got %+v", cfg)
+	}
+
+	peer := &testRecursiveConfig{}
+	cfg = testRecursiveConfig{Peer: peer}
+	peer.Peer = &cfg
+	peer.Fallback = peer
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pointer.String(peer.Name) != "a" {
+		t.Errorf("Expected existing nested pointer to be loaded")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Setenv("TEST_DEBUG", "maybe")
+	t.Setenv("TEST_MASK", strconv.Itoa(256))
+	t.Setenv("TEST_DB_PORT", "-1")
+	t.Setenv("TEST_NAME", "app")
+
+	var cfg testConfig
+	err := Load(&cfg)
+	if err == nil {
+		t.Fatalf("Expected error")
+	}
+	for _, name := range []string{"TEST_DEBUG", "TEST_MASK", "TEST_DB_PORT"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Expected error to mention %s, got %v", name, err)
+		}
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("Expected error to wrap *strconv.NumError")
+	}
+	if e, a := "app", pointer.String(cfg.Name); e != a {
+		t.Errorf("Expected valid variables to be loaded, got %v", a)
+	}
+
+	var bad struct {
+		Name string `env:"TEST_NAME"`
+	}
+	if err := Load(&bad); err == nil {
+		t.Errorf("Expected error for non-pointer field")
+	}
+	if err := Load(cfg); err == nil {
+		t.Errorf("Expected error for non-pointer argument")
+	}
+}
@@ -0,0 +1,278 @@
+package pointer
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// optionalValue is a flag.Value that stores into a pointer field and
+// leaves it nil unless the flag is set. It also implements the Type
+// method of github.com/spf13/pflag.Value.
+type optionalValue[T any] struct {
+	p      **T
+	parse  func(string) (T, error)
+	format func(T) string
+	typ    string
+	isBool bool
+}
+
+func (v *optionalValue[T]) String() string {
+	if v.p == nil || *v.p == nil {
+		return ""
+	}
+	return v.format(**v.p)
+}
+
+func (v *optionalValue[T]) Set(s string) error {
+	val, err := v.parse(s)
+	if err != nil {
+		return err
+	}
+	*v.p = &val
+	return nil
+}
+
+func (v *optionalValue[T]) Type() string {
+	return v.typ
+}
+
+func (v *optionalValue[T]) IsBoolFlag() bool {
+	return v.isBool
+}
+
+// sliceValue is a flag.Value that appends to a slice of pointers. Each
+// occurrence of the flag may hold several values separated by sep, or a
+// single value if sep is empty.
+type sliceValue[T any] struct {
+	p      *[]*T
+	parse  func(string) (T, error)
+	format func(T) string
+	typ    string
+	sep    string
+}
+
+func (v *sliceValue[T]) String() string {
+	if v.p == nil {
+		return ""
+	}
+	s := make([]string, 0, len(*v.p))
+	for _, val := range *v.p {
+		if val != nil {
+			s = append(s, v.format(*val))
+		}
+	}
+	return strings.Join(s, ",")
+}
+
+func (v *sliceValue[T]) Set(s string) error {
+	parts := []string{s}
+	if v.sep != "" {
+		parts = strings.Split(s, v.sep)
+	}
+	vals := make([]*T, 0, len(parts))
+	for _, part := range parts {
+		val, err := v.parse(part)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, &val)
+	}
+	*v.p = append(*v.p, vals...)
+	return nil
+}
+
+func (v *sliceValue[T]) Type() string {
+	return v.typ + "Slice"
+}
+
+func flagSet(fs *flag.FlagSet) *flag.FlagSet {
+	if fs == nil {
+		return flag.CommandLine
+	}
+	return fs
+}
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func formatString(v string) string {
+	return v
+}
+
+func parseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 0, 64)
+}
+
+func formatInt64(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func parseFloat64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func formatFloat64(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func parseTimeLayout(layout string) func(string) (time.Time, error) {
+	return func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	}
+}
+
+func formatTimeLayout(layout string) func(time.Time) string {
+	return func(v time.Time) string {
+		return v.Format(layout)
+	}
+}
+
+// StringFlagValue returns a flag.Value that stores into p. p is left nil
+// unless the flag is set.
+func StringFlagValue(p **string) flag.Value {
+	return &optionalValue[string]{p: p, parse: parseString, format: formatString, typ: "string"}
+}
+
+// StringFlag defines an optional string flag on fs, or on
+// flag.CommandLine if fs is nil. p is left nil unless the flag is set.
+func StringFlag(fs *flag.FlagSet, name string, p **string, usage string) {
+	flagSet(fs).Var(StringFlagValue(p), name, usage)
+}
+
+// StringSliceFlagValue returns a flag.Value that appends to p. The flag
+// may be repeated and each occurrence may hold comma separated values.
+func StringSliceFlagValue(p *[]*string) flag.Value {
+	return &sliceValue[string]{p: p, parse: parseString, format: formatString, typ: "string", sep: ","}
+}
+
+// StringSliceFlag defines a repeatable string flag on fs, or on
+// flag.CommandLine if fs is nil.
+func StringSliceFlag(fs *flag.FlagSet, name string, p *[]*string, usage string) {
+	flagSet(fs).Var(StringSliceFlagValue(p), name, usage)
+}
+
+// BoolFlagValue returns a flag.Value that stores into p. p is left nil
+// unless the flag is set. When used with pflag, set the flag's
+// NoOptDefVal to "true" to allow the flag without a value.
+func BoolFlagValue(p **bool) flag.Value {
+	return &optionalValue[bool]{p: p, parse: strconv.ParseBool, format: strconv.FormatBool, typ: "bool", isBool: true}
+}
+
+// BoolFlag defines an optional bool flag on fs, or on flag.CommandLine
+// if fs is nil. p is left nil unless the flag is set.
+func BoolFlag(fs *flag.FlagSet, name string, p **bool, usage string) {
+	flagSet(fs).Var(BoolFlagValue(p), name, usage)
+}
+
+// BoolSliceFlagValue returns a flag.Value that appends to p. The flag
+// may be repeated and each occurrence may hold comma separated values.
+func BoolSliceFlagValue(p *[]*bool) flag.Value {
+	return &sliceValue[bool]{p: p, parse: strconv.ParseBool, format: strconv.FormatBool, typ: "bool", sep: ","}
+}
+
+// BoolSliceFlag defines a repeatable bool flag on fs, or on
+// flag.CommandLine if fs is nil.
+func BoolSliceFlag(fs *flag.FlagSet, name string, p *[]*bool, usage string) {
+	flagSet(fs).Var(BoolSliceFlagValue(p), name, usage)
+}
+
+// Int64FlagValue returns a flag.Value that stores into p. p is left nil
+// unless the flag is set.
+func Int64FlagValue(p **int64) flag.Value {
+	return &optionalValue[int64]{p: p, parse: parseInt64, format: formatInt64, typ: "int64"}
+}
+
+// Int64Flag defines an optional int64 flag on fs, or on flag.CommandLine
+// if fs is nil. p is left nil unless the flag is set.
+func Int64Flag(fs *flag.FlagSet, name string, p **int64, usage string) {
+	flagSet(fs).Var(Int64FlagValue(p), name, usage)
+}
+
+// Int64SliceFlagValue returns a flag.Value that appends to p. The flag
+// may be repeated and each occurrence may hold comma separated values.
+func Int64SliceFlagValue(p *[]*int64) flag.Value {
+	return &sliceValue[int64]{p: p, parse: parseInt64, format: formatInt64, typ: "int64", sep: ","}
+}
+
+// Int64SliceFlag defines a repeatable int64 flag on fs, or on
+// flag.CommandLine if fs is nil.
+func Int64SliceFlag(fs *flag.FlagSet, name string, p *[]*int64, usage string) {
+	flagSet(fs).Var(Int64SliceFlagValue(p), name, usage)
+}
+
+// Float64FlagValue returns a flag.Value that stores into p. p is left nil
+// unless the flag is set.
+func Float64FlagValue(p **float64) flag.Value {
+	return &optionalValue[float64]{p: p, parse: parseFloat64, format: formatFloat64, typ: "float64"}
+}
+
+// Float64Flag defines an optional float64 flag on fs, or on
+// flag.CommandLine if fs is nil. p is left nil unless the flag is set.
+func Float64Flag(fs *flag.FlagSet, name string, p **float64, usage string) {
+	flagSet(fs).Var(Float64FlagValue(p), name, usage)
+}
+
+// Float64SliceFlagValue returns a flag.Value that appends to p. The flag
+// may be repeated and each occurrence may hold comma separated values.
+func Float64SliceFlagValue(p *[]*float64) flag.Value {
+	return &sliceValue[float64]{p: p, parse: parseFloat64, format: formatFloat64, typ: "float64", sep: ","}
+}
+
+// Float64SliceFlag defines a repeatable float64 flag on fs, or on
+// flag.CommandLine if fs is nil.
+func Float64SliceFlag(fs *flag.FlagSet, name string, p *[]*float64, usage string) {
+	flagSet(fs).Var(Float64SliceFlagValue(p), name, usage)
+}
+
+// DurationFlagValue returns a flag.Value that stores into p. p is left
+// nil unless the flag is set.
+func DurationFlagValue(p **time.Duration) flag.Value {
+	return &optionalValue[time.Duration]{p: p, parse: time.ParseDuration, format: time.Duration.String, typ: "duration"}
+}
+
+// DurationFlag defines an optional time.Duration flag on fs, or on
+// flag.CommandLine if fs is nil. p is left nil unless the flag is set.
+func DurationFlag(fs *flag.FlagSet, name string, p **time.Duration, usage string) {
+	flagSet(fs).Var(DurationFlagValue(p), name, usage)
+}
+
+// DurationSliceFlagValue returns a flag.Value that appends to p. The flag
+// may be repeated and each occurrence may hold comma separated values.
+func DurationSliceFlagValue(p *[]*time.Duration) flag.Value {
+	return &sliceValue[time.Duration]{p: p, parse: time.ParseDuration, format: time.Duration.String, typ: "duration", sep: ","}
+}
+
+// DurationSliceFlag defines a repeatable time.Duration flag on fs, or on
+// flag.CommandLine if fs is nil.
+func DurationSliceFlag(fs *flag.FlagSet, name string, p *[]*time.Duration, usage string) {
+	flagSet(fs).Var(DurationSliceFlagValue(p), name, usage)
+}
+
+// TimeFlagValue returns a flag.Value that stores into p, parsing values
+// with layout. p is left nil unless the flag is set.
+func TimeFlagValue(p **time.Time, layout string) flag.Value {
+	return &optionalValue[time.Time]{p: p, parse: parseTimeLayout(layout), format: formatTimeLayout(layout), typ: "time"}
+}
+
+// TimeFlag defines an optional time.Time flag on fs, or on
+// flag.CommandLine if fs is nil, parsing values with layout. p is left
+// nil unless the flag is set.
+func TimeFlag(fs *flag.FlagSet, name string, p **time.Time, layout string, usage string) {
+	flagSet(fs).Var(TimeFlagValue(p, layout), name, usage)
+}
+
+// TimeSliceFlagValue returns a flag.Value that appends to p, parsing
+// values with layout. The flag may be repeated and each occurrence holds
+// a single value, as layouts such as time.RFC1123 contain commas.
+func TimeSliceFlagValue(p *[]*time.Time, layout string) flag.Value {
+	return &sliceValue[time.Time]{p: p, parse: parseTimeLayout(layout), format: formatTimeLayout(layout), typ: "time"}
+}
+
+// TimeSliceFlag defines a repeatable time.Time flag on fs, or on
+// flag.CommandLine if fs is nil, parsing values with layout.
+func TimeSliceFlag(fs *flag.FlagSet, name string, p *[]*time.Time, layout string, usage string) {
+	flagSet(fs).Var(TimeSliceFlagValue(p, layout), name, usage)
+}
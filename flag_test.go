@@ -0,0 +1,157 @@
+package pointer
+
+import (
+	"flag"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type flagTestConfig struct {
+	Name     *string
+	Verbose  *bool
+	Limit    *int64
+	Ratio    *float64
+	Timeout  *time.Duration
+	Since    *time.Time
+	Tags     []*string
+	Ports    []*int64
+	Weights  []*float64
+	Toggles  []*bool
+	Backoffs []*time.Duration
+	Dates    []*time.Time
+}
+
+func newFlagTestSet(cfg *flagTestConfig) *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	StringFlag(fs, "name", &cfg.Name, "")
+	BoolFlag(fs, "verbose", &cfg.Verbose, "")
+	Int64Flag(fs, "limit", &cfg.Limit, "")
+	Float64Flag(fs, "ratio", &cfg.Ratio, "")
+	DurationFlag(fs, "timeout", &cfg.Timeout, "")
+	TimeFlag(fs, "since", &cfg.Since, time.DateOnly, "")
+	StringSliceFlag(fs, "tag", &cfg.Tags, "")
+	Int64SliceFlag(fs, "port", &cfg.Ports, "")
+	Float64SliceFlag(fs, "weight", &cfg.Weights, "")
+	BoolSliceFlag(fs, "toggle", &cfg.Toggles, "")
+	DurationSliceFlag(fs, "backoff", &cfg.Backoffs, "")
+	TimeSliceFlag(fs, "date", &cfg.Dates, time.DateOnly, "")
+	return fs
+}
+
+func TestFlagsUnset(t *testing.T) {
+	var cfg flagTestConfig
+	if err := newFlagTestSet(&cfg).Parse(nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(flagTestConfig{}, cfg) {
+		t.Errorf("Expected all fields to stay nil, got %+v", cfg)
+	}
+}
+
+func TestFlagsZeroValues(t *testing.T) {
+	var cfg flagTestConfig
+	args := []string{"-name=", "-verbose=false", "-limit=0", "-ratio=0", "-timeout=0s"}
+	if err := newFlagTestSet(&cfg).Parse(args); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Name == nil || cfg.Verbose == nil || cfg.Limit == nil || cfg.Ratio == nil || cfg.Timeout == nil {
+		t.Errorf("Expected zero values to be set, got %+v", cfg)
+	}
+}
+
+func TestFlags(t *testing.T) {
+	var cfg flagTestConfig
+	args := []string{
+		"-name", "a", "-verbose", "-limit", "0x10", "-ratio", "0.5", "-timeout", "1m",
+		"-since", "2021-03-04", "-tag", "a,b", "-tag", "c", "-port", "80,443",
+		"-weight", "1.5", "-toggle", "true,false", "-backoff", "1s,2s", "-date", "2021-03-04",
+	}
+	fs := newFlagTestSet(&cfg)
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := "a", String(cfg.Name); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if !Bool(cfg.Verbose) {
+		t.Errorf("Expected verbose to be true")
+	}
+	if e, a := int64(16), Int64(cfg.Limit); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := 0.5, Float64(cfg.Ratio); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := time.Minute, *cfg.Timeout; e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), Time(cfg.Since); !e.Equal(a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := []string{"a", "b", "c"}, StringSlice(cfg.Tags); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := []int64{80, 443}, Int64Slice(cfg.Ports); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := []bool{true, false}, BoolSlice(cfg.Toggles); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := 2, len(cfg.Backoffs); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "a,b,c", fs.Lookup("tag").Value.String(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "2021-03-04", fs.Lookup("since").Value.String(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestFlagsInvalid(t *testing.T) {
+	for _, args := range [][]string{{"-limit", "x"}, {"-verbose=maybe"}, {"-port", "1,x"}, {"-since", "yesterday"}} {
+		var cfg flagTestConfig
+		if err := newFlagTestSet(&cfg).Parse(args); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
+
+func TestFlagsInvalidSliceUnchanged(t *testing.T) {
+	var cfg flagTestConfig
+	if err := newFlagTestSet(&cfg).Parse([]string{"-port", "80", "-port", "1,x"}); err == nil {
+		t.Fatalf("Expected error")
+	}
+	if e, a := []int64{80}, Int64Slice(cfg.Ports); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestTimeSliceFlagLayoutWithComma(t *testing.T) {
+	var dates []*time.Time
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	TimeSliceFlag(fs, "date", &dates, time.RFC1123, "")
+	args := []string{"-date", "Thu, 04 Mar 2021 05:06:07 UTC", "-date", "Fri, 05 Mar 2021 05:06:07 UTC"}
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	e := []time.Time{time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), time.Date(2021, 3, 5, 5, 6, 7, 0, time.UTC)}
+	if a := TimeSlice(dates); len(a) != len(e) || !e[0].Equal(a[0]) || !e[1].Equal(a[1]) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestFlagValueType(t *testing.T) {
+	var s *string
+	var ss []*string
+	if e, a := "string", StringFlagValue(&s).(interface{ Type() string }).Type(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "stringSlice", StringSliceFlagValue(&ss).(interface{ Type() string }).Type(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
@@ -1,3 +1,3 @@
 module gomodules.xyz/pointer
 
-go 1.15
+go 1.21
@@ -0,0 +1,170 @@
+package pointer
+
+import (
+	"fmt"
+	"reflect"
+
+	"gomodules.xyz/pointer/internal/structs"
+)
+
+type inspectOptions struct {
+	recursive  bool
+	emptyAsNil bool
+	jsonNames  bool
+}
+
+// InspectOption configures AllNil, AnySet, SetFields and NilFields.
+type InspectOption func(*inspectOptions)
+
+// Recursive makes the inspection walk nested structs and non-nil
+// pointers to structs, reporting their fields instead of the pointer
+// itself. A pointer to a struct whose fields are all nil therefore counts
+// as nil, while a pointer to a struct without fields to report, or one
+// back to a struct being walked, is reported as set. Structs that encode
+// themselves, such as time.Time, are not walked.
+func Recursive() InspectOption {
+	return func(o *inspectOptions) {
+		o.recursive = true
+	}
+}
+
+// EmptyAsNil makes the inspection consider slice and map fields too,
+// treating empty ones as nil.
+func EmptyAsNil() InspectOption {
+	return func(o *inspectOptions) {
+		o.emptyAsNil = true
+	}
+}
+
+// JSONNames makes SetFields and NilFields report fields by their json
+// tag names, promoting the fields of embedded structs and pointers to
+// structs and skipping fields tagged "-" like encoding/json does.
+func JSONNames() InspectOption {
+	return func(o *inspectOptions) {
+		o.jsonNames = true
+	}
+}
+
+// AllNil reports whether every pointer field of the struct v is nil. v
+// must be a struct or a pointer to a struct; any other type will cause a
+// panic. A nil pointer to a struct has no set fields.
+func AllNil(v interface{}, opts ...InspectOption) bool {
+	all := true
+	inspectFields(v, opts, func(_ string, set bool) {
+		all = all && !set
+	})
+	return all
+}
+
+// AnySet reports whether any pointer field of the struct v is non-nil. It
+// is the negation of AllNil.
+func AnySet(v interface{}, opts ...InspectOption) bool {
+	return !AllNil(v, opts...)
+}
+
+// SetFields returns the dot separated paths of the non-nil pointer fields
+// of the struct v, in field order. Fields of embedded structs and
+// pointers to structs are reported without the name of the embedded
+// struct.
+func SetFields(v interface{}, opts ...InspectOption) []string {
+	var fields []string
+	inspectFields(v, opts, func(path string, set bool) {
+		if set {
+			fields = append(fields, path)
+		}
+	})
+	return fields
+}
+
+// NilFields returns the dot separated paths of the nil pointer fields of
+// the struct v, in field order.
+func NilFields(v interface{}, opts ...InspectOption) []string {
+	var fields []string
+	inspectFields(v, opts, func(path string, set bool) {
+		if !set {
+			fields = append(fields, path)
+		}
+	})
+	return fields
+}
+
+func inspectFields(v interface{}, opts []InspectOption, fn func(path string, set bool)) {
+	var o inspectOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	visiting := make(map[ptrKey]bool)
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.Type().Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("pointer: cannot inspect fields of non-struct type %s", rv.Type()))
+		}
+		if rv.IsNil() {
+			return
+		}
+		visiting[keyOf(rv)] = true
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("pointer: cannot inspect fields of non-struct type %T", v))
+	}
+	o.inspect(rv, "", visiting, fn)
+}
+
+// inspect calls fn for the fields of the struct v. Pointers in visiting
+// are being walked already and are not followed again.
+func (o inspectOptions) inspect(v reflect.Value, prefix string, visiting map[ptrKey]bool, fn func(path string, set bool)) {
+	for _, f := range o.fields(v.Type()) {
+		fv := field(v, f)
+		path := prefix + f.Name
+		switch fv.Kind() {
+		case reflect.Ptr:
+			if o.recursive && !fv.IsNil() && isInspectableStruct(fv.Type().Elem()) && !visiting[keyOf(fv)] {
+				n := 0
+				visiting[keyOf(fv)] = true
+				o.inspect(fv.Elem(), path+".", visiting, func(path string, set bool) {
+					n++
+					fn(path, set)
+				})
+				delete(visiting, keyOf(fv))
+				if n > 0 {
+					continue
+				}
+			}
+			fn(path, !fv.IsNil())
+		case reflect.Slice, reflect.Map:
+			if o.emptyAsNil {
+				fn(path, fv.Len() > 0)
+			}
+		case reflect.Struct:
+			if o.recursive && isInspectableStruct(fv.Type()) {
+				o.inspect(fv, path+".", visiting, fn)
+			}
+		}
+	}
+}
+
+// fields returns the fields of the struct type t with the names they are
+// reported by. The fields of embedded structs and pointers to structs are
+// promoted.
+func (o inspectOptions) fields(t reflect.Type) []structs.Field {
+	if o.jsonNames {
+		return structs.JSONFields(t)
+	}
+	return structs.GoFields(t)
+}
+
+// field returns the field f of the struct v, or its zero value if it is
+// promoted through a nil embedded pointer.
+func field(v reflect.Value, f structs.Field) reflect.Value {
+	if fv, ok := structs.FieldByIndex(v, f.Index, false); ok {
+		return fv
+	}
+	return reflect.Zero(f.Type)
+}
+
+// isInspectableStruct reports whether t is a struct whose fields can be
+// walked, rather than one that encodes itself like time.Time.
+func isInspectableStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && isStructValue(reflect.Zero(t))
+}
@@ -0,0 +1,161 @@
+package pointer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type inspectTestMeta struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type inspectTestSpec struct {
+	Replicas *int32            `json:"replicas,omitempty"`
+	Args     []string          `json:"args,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+}
+
+type inspectTestPatch struct {
+	inspectTestMeta `json:",inline"`
+	Spec            *inspectTestSpec `json:"spec,omitempty"`
+	Status          inspectTestSpec  `json:"status"`
+	Deadline        *time.Time       `json:"deadline,omitempty"`
+	Internal        *string          `json:"-"`
+	count           *int
+}
+
+func TestAllNil(t *testing.T) {
+	var p inspectTestPatch
+	if !AllNil(p) || AnySet(&p) || !AllNil((*inspectTestPatch)(nil)) {
+		t.Errorf("Expected empty patch to be all nil")
+	}
+	p.count = IntP(1)
+	p.Status.Replicas = Int32P(1)
+	if !AllNil(p) {
+		t.Errorf("Expected unexported and nested fields to be ignored")
+	}
+	if AllNil(p, Recursive()) {
+		t.Errorf("Expected nested field to be found")
+	}
+
+	p = inspectTestPatch{Spec: &inspectTestSpec{Args: []string{}}}
+	if AllNil(p) {
+		t.Errorf("Expected non-nil struct pointer to be set")
+	}
+	if !AllNil(p, Recursive(), EmptyAsNil()) {
+		t.Errorf("Expected empty nested struct to count as nil")
+	}
+	p.Spec.Args = []string{"a"}
+	if AllNil(p, Recursive(), EmptyAsNil()) || !AllNil(p, Recursive()) {
+		t.Errorf("Expected non-empty slice to count as set only with EmptyAsNil")
+	}
+}
+
+func TestSetFields(t *testing.T) {
+	p := inspectTestPatch{
+		inspectTestMeta: inspectTestMeta{Name: StringP("a")},
+		Spec:            &inspectTestSpec{Replicas: Int32P(1), Labels: map[string]string{}},
+		Deadline:        TimeP(time.Now()),
+	}
+	testCases := []struct {
+		opts    []InspectOption
+		set     []string
+		nilKeys []string
+	}{
+		{
+			set:     []string{"Name", "Spec", "Deadline"},
+			nilKeys: []string{"Internal"},
+		},
+		{
+			opts:    []InspectOption{Recursive()},
+			set:     []string{"Name", "Spec.Replicas", "Deadline"},
+			nilKeys: []string{"Status.Replicas", "Internal"},
+		},
+		{
+			opts:    []InspectOption{Recursive(), JSONNames(), EmptyAsNil()},
+			set:     []string{"name", "spec.replicas", "deadline"},
+			nilKeys: []string{"spec.args", "spec.labels", "status.replicas", "status.args", "status.labels"},
+		},
+	}
+	for idx, c := range testCases {
+		if e, a := c.set, SetFields(&p, c.opts...); !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected set fields at idx %d: expected %v, got %v", idx, e, a)
+		}
+		if e, a := c.nilKeys, NilFields(p, c.opts...); !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected nil fields at idx %d: expected %v, got %v", idx, e, a)
+		}
+	}
+}
+
+func TestSetFieldsEmbeddedPointer(t *testing.T) {
+	p := struct {
+		*inspectTestMeta
+		Spec *inspectTestSpec `json:"spec"`
+	}{}
+	if e, a := []string{"name", "spec"}, NilFields(p, JSONNames()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields: expected %v, got %v", e, a)
+	}
+	p.inspectTestMeta = &inspectTestMeta{Name: StringP("a")}
+	if e, a := []string{"name"}, SetFields(p, JSONNames()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected set fields: expected %v, got %v", e, a)
+	}
+	if e, a := []string{"Name"}, SetFields(p); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected set fields: expected %v, got %v", e, a)
+	}
+}
+
+type inspectTestPort struct {
+	Port int
+}
+
+type inspectTestNode struct {
+	Name *string
+	Next *inspectTestNode
+	Port *inspectTestPort
+	At   *time.Time
+}
+
+func TestInspectRecursiveLeaves(t *testing.T) {
+	n := inspectTestNode{Port: &inspectTestPort{Port: 80}}
+	if AllNil(n, Recursive()) {
+		t.Errorf("Expected pointer to struct without pointer fields to be set")
+	}
+	if e, a := []string{"Port"}, SetFields(n, Recursive()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected set fields: expected %v, got %v", e, a)
+	}
+	n = inspectTestNode{At: TimeP(time.Now())}
+	if e, a := []string{"At"}, SetFields(n, Recursive()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected set fields: expected %v, got %v", e, a)
+	}
+}
+
+func TestInspectRecursiveCycle(t *testing.T) {
+	n := &inspectTestNode{}
+	n.Next = n
+	if AllNil(n, Recursive()) {
+		t.Errorf("Expected pointer back to the root to be set")
+	}
+	if e, a := []string{"Next"}, SetFields(n, Recursive()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected set fields: expected %v, got %v", e, a)
+	}
+	m := &inspectTestNode{Next: n}
+	n.Next = m
+	e := []string{"Name", "Next.Name", "Next.Port", "Next.At", "Port", "At"}
+	if a := NilFields(m, Recursive()); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields: expected %v, got %v", e, a)
+	}
+}
+
+func TestInspectPanics(t *testing.T) {
+	for _, v := range []interface{}{nil, 1, StringP("a")} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic for %#v", v)
+				}
+			}()
+			AllNil(v)
+		}()
+	}
+}
@@ -0,0 +1,187 @@
+// Package structs lists the fields of struct types the way encoding/json
+// and Go field promotion see them, for the reflection based helpers of
+// the pointer packages.
+package structs
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Field describes a field of a struct type, including fields promoted
+// from embedded structs and pointers to structs.
+type Field struct {
+	// Name is the json name of the field, or its Go name for GoFields.
+	Name string
+	// Index is the index sequence for reflect.Value.FieldByIndex.
+	Index []int
+	// Type is the type of the field.
+	Type reflect.Type
+	// Embedded reports whether the field is reached through an embedded
+	// pointer, which makes it absent if that pointer is nil.
+	Embedded bool
+	// OmitEmpty and Quoted report the omitempty and string json options.
+	OmitEmpty bool
+	Quoted    bool
+
+	tagged bool
+}
+
+// JSONFields returns the fields of the struct type t that encoding/json
+// encodes, in the order it encodes them. Names follow the json tags,
+// fields tagged "-" are skipped, and the fields of embedded structs and
+// pointers to structs without a json name are promoted following the
+// dominance rules of encoding/json.
+func JSONFields(t reflect.Type) []Field {
+	return fields(t, true)
+}
+
+// GoFields returns the exported fields of the struct type t by their Go
+// names, promoting the fields of embedded structs and pointers to structs
+// following the selector rules of Go. Tags are ignored.
+func GoFields(t reflect.Type) []Field {
+	return fields(t, false)
+}
+
+func fields(t reflect.Type, useTags bool) []Field {
+	type embedded struct {
+		typ      reflect.Type
+		index    []int
+		embedded bool
+	}
+	var all []Field
+	var current []embedded
+	next := []embedded{{typ: t}}
+	visited := map[reflect.Type]bool{}
+	for len(next) > 0 {
+		current, next = next, nil
+		level := map[reflect.Type]bool{}
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			level[e.typ] = true
+			for i := 0; i < e.typ.NumField(); i++ {
+				sf := e.typ.Field(i)
+				ft := sf.Type
+				if sf.Anonymous && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if sf.Anonymous {
+					if !sf.IsExported() && ft.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				var name, opts string
+				if useTags {
+					tag := sf.Tag.Get("json")
+					if tag == "-" {
+						continue
+					}
+					name, opts, _ = strings.Cut(tag, ",")
+				}
+				index := append(append([]int{}, e.index...), i)
+				if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+					next = append(next, embedded{
+						typ:      ft,
+						index:    index,
+						embedded: e.embedded || sf.Type.Kind() == reflect.Ptr,
+					})
+					continue
+				}
+				if !sf.IsExported() {
+					continue
+				}
+				tagged := name != ""
+				if !tagged {
+					name = sf.Name
+				}
+				opts = "," + opts + ","
+				all = append(all, Field{
+					Name:      name,
+					Index:     index,
+					Type:      sf.Type,
+					Embedded:  e.embedded,
+					OmitEmpty: strings.Contains(opts, ",omitempty,"),
+					Quoted:    strings.Contains(opts, ",string,"),
+					tagged:    tagged,
+				})
+			}
+		}
+		for t := range level {
+			visited[t] = true
+		}
+	}
+
+	// Keep the dominant field of each name: the shallowest one, preferring
+	// a tagged one. Fields that remain ambiguous are dropped.
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Name != all[j].Name {
+			return all[i].Name < all[j].Name
+		}
+		if len(all[i].Index) != len(all[j].Index) {
+			return len(all[i].Index) < len(all[j].Index)
+		}
+		return all[i].tagged && !all[j].tagged
+	})
+	out := all[:0]
+	for i := 0; i < len(all); {
+		j := i + 1
+		for j < len(all) && all[j].Name == all[i].Name {
+			j++
+		}
+		if j-i == 1 || len(all[i].Index) != len(all[i+1].Index) || all[i].tagged != all[i+1].tagged {
+			out = append(out, all[i])
+		}
+		i = j
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i].Index, out[j].Index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return out
+}
+
+// Find looks up a field by name, preferring an exact match over a
+// case-insensitive one like encoding/json does.
+func Find(fields []Field, name string) (Field, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// FieldByIndex is like v.FieldByIndex but follows the pointers of embedded
+// structs one step at a time. At a nil embedded pointer it reports false,
+// or allocates the pointer if alloc is set. Unexported embedded pointers
+// cannot be allocated. v may be a nil pointer to a struct.
+func FieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for _, x := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return v, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
@@ -0,0 +1,144 @@
+package structs
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type Meta struct {
+	Name *string `json:"name"`
+	UID  string
+}
+
+type Labels struct {
+	Labels map[string]string `json:"labels"`
+	Name   *string
+}
+
+type hidden struct {
+	Hidden string `json:"hidden"`
+}
+
+type Object struct {
+	*Meta
+	Labels `json:",inline"`
+	hidden
+	Spec   *string `json:"spec,omitempty"`
+	Count  *int64  `json:"count,string"`
+	Skip   string  `json:"-"`
+	Nested Labels  `json:"nested"`
+	count  int
+}
+
+type Ambiguous struct {
+	Meta
+	Other
+}
+
+type Other struct {
+	UID string
+	ID  string
+}
+
+type Recursive struct {
+	*Recursive
+	Value int
+}
+
+var testCasesFields = []struct {
+	// in must have its embedded pointers and omitempty fields set, so
+	// that encoding/json encodes every field.
+	in       interface{}
+	json     []string
+	goFields []string
+}{
+	{Object{Meta: &Meta{}, Spec: new(string)}, []string{"name", "UID", "labels", "Name", "hidden", "spec", "count", "nested"}, []string{"UID", "Labels", "Hidden", "Spec", "Count", "Skip", "Nested"}},
+	{Ambiguous{}, []string{"name", "ID"}, []string{"Name", "ID"}},
+	{Recursive{Recursive: &Recursive{}}, []string{"Value"}, []string{"Value"}},
+}
+
+func names(fields []Field) []string {
+	out := []string{}
+	for _, f := range fields {
+		out = append(out, f.Name)
+	}
+	return out
+}
+
+func TestFields(t *testing.T) {
+	for idx, c := range testCasesFields {
+		typ := reflect.TypeOf(c.in)
+		if e, a := c.json, names(JSONFields(typ)); !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected json fields at idx %d, expected %v, got %v", idx, e, a)
+		}
+		var m map[string]interface{}
+		data, _ := json.Marshal(c.in)
+		if err := json.Unmarshal(data, &m); err != nil {
+			t.Fatal(err)
+		}
+		e := []string{}
+		for k := range m {
+			e = append(e, k)
+		}
+		a := names(JSONFields(typ))
+		sort.Strings(e)
+		sort.Strings(a)
+		if !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected json fields at idx %d, encoding/json has %v, got %v", idx, e, a)
+		}
+		if e, a := c.goFields, names(GoFields(typ)); !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected Go fields at idx %d, expected %v, got %v", idx, e, a)
+		}
+	}
+}
+
+func TestFieldOptions(t *testing.T) {
+	fields := JSONFields(reflect.TypeOf(Object{}))
+	f, _ := Find(fields, "name")
+	if !f.Embedded || !reflect.DeepEqual(f.Index, []int{0, 0}) {
+		t.Errorf("Unexpected field %+v", f)
+	}
+	f, _ = Find(fields, "labels")
+	if f.Embedded {
+		t.Errorf("Unexpected embedded field %+v", f)
+	}
+	if f, _ := Find(fields, "spec"); !f.OmitEmpty || f.Quoted {
+		t.Errorf("Unexpected options of field %+v", f)
+	}
+	if f, _ := Find(fields, "count"); f.OmitEmpty || !f.Quoted {
+		t.Errorf("Unexpected options of field %+v", f)
+	}
+	if f, ok := Find(fields, "Nested"); !ok || f.Name != "nested" {
+		t.Errorf("Expected case-insensitive match, got %+v", f)
+	}
+	if _, ok := Find(fields, "Skip"); ok {
+		t.Errorf("Expected no field for Skip")
+	}
+}
+
+func TestFieldByIndex(t *testing.T) {
+	index := []int{0, 0}
+	var o Object
+	if _, ok := FieldByIndex(reflect.ValueOf(o), index, false); ok {
+		t.Errorf("Expected nil embedded pointer to stop")
+	}
+	if _, ok := FieldByIndex(reflect.ValueOf(&o).Elem(), index, false); ok || o.Meta != nil {
+		t.Errorf("Expected nil embedded pointer to stay nil")
+	}
+	if _, ok := FieldByIndex(reflect.ValueOf(o), index, true); ok {
+		t.Errorf("Expected unaddressable embedded pointer to stop")
+	}
+	fv, ok := FieldByIndex(reflect.ValueOf(&o).Elem(), index, true)
+	if !ok || o.Meta == nil {
+		t.Fatalf("Expected embedded pointer to be allocated")
+	}
+	fv.Set(reflect.ValueOf(new(string)))
+	if o.Meta.Name == nil {
+		t.Errorf("Expected field to be set")
+	}
+	if _, ok := FieldByIndex(reflect.ValueOf((*Object)(nil)), []int{4}, false); ok {
+		t.Errorf("Expected nil root to stop")
+	}
+}
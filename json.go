@@ -0,0 +1,216 @@
+package pointer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// NilPolicy controls how nil elements of []*T and map[K]*T values are
+// encoded by MarshalWithNil.
+type NilPolicy int
+
+const (
+	// NilNull encodes nil elements as JSON null, same as encoding/json.
+	NilNull NilPolicy = iota
+	// NilOmit drops nil slice elements and nil map entries.
+	NilOmit
+	// NilZero encodes nil elements as the zero value of their type.
+	NilZero
+)
+
+// PSlice is a slice of pointers whose nil elements are omitted when
+// marshaled to JSON. It decodes like a plain []*T.
+type PSlice[T any] []*T
+
+// MarshalJSON implements json.Marshaler.
+func (s PSlice[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	dst := make([]*T, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil {
+			dst = append(dst, s[i])
+		}
+	}
+	return json.Marshal(dst)
+}
+
+// PMap is a string map of pointers whose nil entries are omitted when
+// marshaled to JSON. It decodes like a plain map[string]*T.
+type PMap[T any] map[string]*T
+
+// MarshalJSON implements json.Marshaler.
+func (m PMap[T]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+	dst := make(map[string]*T, len(m))
+	for k, val := range m {
+		if val != nil {
+			dst[k] = val
+		}
+	}
+	return json.Marshal(dst)
+}
+
+// MarshalSkipNil returns the JSON encoding of v with nil elements of
+// every []*T and map[K]*T inside v left out.
+func MarshalSkipNil(v interface{}) ([]byte, error) {
+	return MarshalWithNil(v, NilOmit)
+}
+
+// MarshalWithNil returns the JSON encoding of v with nil elements of
+// every []*T and map[K]*T inside v encoded according to policy. v itself
+// is not modified. The output can be fed to JSON based YAML encoders such
+// as sigs.k8s.io/yaml. Like json.Marshal, it returns a
+// *json.UnsupportedValueError if v contains a cycle.
+func MarshalWithNil(v interface{}, policy NilPolicy) ([]byte, error) {
+	if v == nil || policy == NilNull {
+		return json.Marshal(v)
+	}
+	rv, err := applyNilPolicy(reflect.ValueOf(v), policy, make(map[cycleKey]bool))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(rv.Interface())
+}
+
+// cycleKey identifies a pointer, map or slice being copied. Slices are
+// keyed on their length too, as different slices can share an array.
+type cycleKey struct {
+	ptrKey
+	len int
+}
+
+// applyNilPolicy returns a copy of v with nil pointer elements of slices
+// and maps rewritten according to policy. Values that do not contain
+// slices or maps of pointers are returned as is. Keys in visiting are
+// being copied already; reaching one again is reported like json.Marshal
+// reports cycles.
+func applyNilPolicy(v reflect.Value, policy NilPolicy, visiting map[cycleKey]bool) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, nil
+		}
+		switch v.Elem().Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+			k := cycleKey{ptrKey: keyOf(v)}
+			if visiting[k] {
+				return v, cycleError(v)
+			}
+			visiting[k] = true
+			defer delete(visiting, k)
+			elem, err := applyNilPolicy(v.Elem(), policy, visiting)
+			if err != nil {
+				return v, err
+			}
+			dst := reflect.New(v.Type().Elem())
+			dst.Elem().Set(elem)
+			return dst, nil
+		}
+		return v, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		elem, err := applyNilPolicy(v.Elem(), policy, visiting)
+		if err != nil {
+			return v, err
+		}
+		dst := reflect.New(v.Type()).Elem()
+		dst.Set(elem)
+		return dst, nil
+	case reflect.Struct:
+		dst := reflect.New(v.Type()).Elem()
+		dst.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			f, err := applyNilPolicy(v.Field(i), policy, visiting)
+			if err != nil {
+				return v, err
+			}
+			dst.Field(i).Set(f)
+		}
+		return dst, nil
+	case reflect.Array:
+		dst := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			elem, err := applyNilPolicy(v.Index(i), policy, visiting)
+			if err != nil {
+				return v, err
+			}
+			dst.Index(i).Set(elem)
+		}
+		return dst, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return v, nil
+		}
+		k := cycleKey{keyOf(v), v.Len()}
+		if visiting[k] {
+			return v, cycleError(v)
+		}
+		visiting[k] = true
+		defer delete(visiting, k)
+		dst := reflect.MakeSlice(v.Type(), 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, ok, err := applyNilPolicyElem(v.Index(i), policy, visiting)
+			if err != nil {
+				return v, err
+			}
+			if ok {
+				dst = reflect.Append(dst, elem)
+			}
+		}
+		return dst, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+		k := cycleKey{ptrKey: keyOf(v)}
+		if visiting[k] {
+			return v, cycleError(v)
+		}
+		visiting[k] = true
+		defer delete(visiting, k)
+		dst := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			elem, ok, err := applyNilPolicyElem(iter.Value(), policy, visiting)
+			if err != nil {
+				return v, err
+			}
+			if ok {
+				dst.SetMapIndex(iter.Key(), elem)
+			}
+		}
+		return dst, nil
+	}
+	return v, nil
+}
+
+// applyNilPolicyElem rewrites a single slice element or map value. It
+// reports false if the element should be dropped.
+func applyNilPolicyElem(v reflect.Value, policy NilPolicy, visiting map[cycleKey]bool) (reflect.Value, bool, error) {
+	if v.Kind() != reflect.Ptr || !v.IsNil() {
+		elem, err := applyNilPolicy(v, policy, visiting)
+		return elem, true, err
+	}
+	switch policy {
+	case NilOmit:
+		return v, false, nil
+	case NilZero:
+		return reflect.New(v.Type().Elem()), true, nil
+	}
+	return v, true, nil
+}
+
+// cycleError returns the error json.Marshal returns for a cycle via v.
+func cycleError(v reflect.Value) error {
+	return &json.UnsupportedValueError{Value: v, Str: fmt.Sprintf("encountered a cycle via %s", v.Type())}
+}
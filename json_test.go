@@ -0,0 +1,142 @@
+package pointer
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPSliceJSON(t *testing.T) {
+	in := PSlice[string]{StringP("a"), nil, StringP("b")}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := `["a","b"]`, string(b); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	var out PSlice[string]
+	if err := json.Unmarshal([]byte(`["a",null,"b"]`), &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := []string{"a", "", "b"}, StringSlice(out); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if out[1] != nil {
+		t.Errorf("Expected nil element to be decoded as nil")
+	}
+
+	var empty PSlice[int]
+	if b, _ := json.Marshal(empty); string(b) != "null" {
+		t.Errorf("Expected null, got %s", b)
+	}
+}
+
+func TestPMapJSON(t *testing.T) {
+	in := PMap[int64]{"a": Int64P(1), "b": nil}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := `{"a":1}`, string(b); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	var out PMap[int64]
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := map[string]int64{"a": 1}, Int64Map(out); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+type nilPolicyTestStruct struct {
+	Names  []*string            `json:"names"`
+	Counts map[string]*int      `json:"counts"`
+	Nested *nilPolicyTestStruct `json:"nested,omitempty"`
+	Name   *string              `json:"name"`
+}
+
+var testCasesMarshalWithNil = []struct {
+	policy NilPolicy
+	out    string
+}{
+	{
+		policy: NilNull,
+		out:    `{"names":["a",null],"counts":{"x":1,"y":null},"nested":{"names":[null],"counts":null,"name":null},"name":null}`,
+	},
+	{
+		policy: NilOmit,
+		out:    `{"names":["a"],"counts":{"x":1},"nested":{"names":[],"counts":null,"name":null},"name":null}`,
+	},
+	{
+		policy: NilZero,
+		out:    `{"names":["a",""],"counts":{"x":1,"y":0},"nested":{"names":[""],"counts":null,"name":null},"name":null}`,
+	},
+}
+
+func TestMarshalWithNil(t *testing.T) {
+	in := nilPolicyTestStruct{
+		Names:  []*string{StringP("a"), nil},
+		Counts: map[string]*int{"x": IntP(1), "y": nil},
+		Nested: &nilPolicyTestStruct{
+			Names: []*string{nil},
+		},
+	}
+	for idx, c := range testCasesMarshalWithNil {
+		b, err := MarshalWithNil(in, c.policy)
+		if err != nil {
+			t.Fatalf("Unexpected error at idx %d: %v", idx, err)
+		}
+		if e, a := c.out, string(b); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %s, got %s", idx, e, a)
+		}
+	}
+	if in.Names[1] != nil || in.Nested.Names[0] != nil {
+		t.Errorf("Expected input to be left unmodified")
+	}
+}
+
+func TestMarshalSkipNilRoundTrip(t *testing.T) {
+	in := map[string][]*string{"tags": StringPSlice([]string{"a", "b"})}
+	in["tags"] = append(in["tags"], nil)
+	b, err := MarshalSkipNil(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out map[string][]*string
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := []string{"a", "b"}, StringSlice(out["tags"]); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestMarshalWithNilCycle(t *testing.T) {
+	n := &nilPolicyTestStruct{Names: []*string{nil}}
+	n.Nested = n
+	m := map[string]interface{}{"a": nil}
+	m["a"] = m
+	s := []interface{}{nil}
+	s[0] = s
+	for idx, v := range []interface{}{n, m, s} {
+		_, err := MarshalSkipNil(v)
+		var e *json.UnsupportedValueError
+		if !errors.As(err, &e) {
+			t.Errorf("Expected cycle error at idx %d, got %v", idx, err)
+		}
+	}
+
+	shared := &nilPolicyTestStruct{Names: []*string{nil}}
+	b, err := MarshalSkipNil([]*nilPolicyTestStruct{shared, shared})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := `[{"names":[],"counts":null,"name":null},{"names":[],"counts":null,"name":null}]`, string(b); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
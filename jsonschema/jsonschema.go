@@ -0,0 +1,264 @@
+// Package jsonschema generates JSON Schema (draft 2020-12) documents for Go
+// structs built from optional pointer fields.
+//
+//	type Spec struct {
+//		Name     string         `json:"name"`
+//		Replicas *int32         `json:"replicas,omitempty"`
+//		Deadline *time.Time     `json:"deadline,omitempty"`
+//		Timeout  *time.Duration `json:"timeout,omitempty"`
+//	}
+//
+// Pointer fields are optional and nullable, other fields are required
+// unless tagged omitempty. Slices and maps are nullable too, as
+// encoding/json encodes nil ones as null. Field names follow encoding/json, including
+// promotion of embedded structs. Named struct types other than the root
+// are emitted once under $defs and referenced with $ref.
+package jsonschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gomodules.xyz/pointer/internal/structs"
+)
+
+// Draft is the $schema URI of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// DurationPattern is the pattern of time.Duration values encoded as
+// strings in the format of time.Duration.String. encoding/json encodes a
+// time.Duration as an integer number of nanoseconds, so its schema
+// accepts either form.
+const DurationPattern = `^-?([0-9]+(\.[0-9]*)?(ns|us|µs|ms|s|m|h))+$|^0$`
+
+// Schema is a JSON Schema document or subschema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 Type               `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Type is the list of JSON types a value may have. It is encoded as a
+// single string if it holds one type.
+type Type []string
+
+// MarshalJSON implements json.Marshaler.
+func (t Type) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Type) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = Type{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Reflect returns the schema of the type of v, which must be a struct or
+// a pointer to a struct.
+func Reflect(v interface{}) (*Schema, error) {
+	return ReflectType(reflect.TypeOf(v))
+}
+
+// ReflectType returns the schema of t, which must be a struct type or a
+// pointer to a struct type.
+func ReflectType(t reflect.Type) (*Schema, error) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("jsonschema: %v is not a struct type", t)
+	}
+	r := reflector{root: t, names: map[reflect.Type]string{}, defs: map[string]*Schema{}}
+	s, err := r.structSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	s.Schema = Draft
+	if len(r.defs) > 0 {
+		s.Defs = r.defs
+	}
+	return s, nil
+}
+
+type reflector struct {
+	root  reflect.Type
+	names map[reflect.Type]string
+	defs  map[string]*Schema
+}
+
+func (r *reflector) schema(t reflect.Type) (*Schema, error) {
+	if t.Kind() == reflect.Ptr {
+		s, err := r.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(s), nil
+	}
+	switch {
+	case t == timeType:
+		return &Schema{Type: Type{"string"}, Format: "date-time"}, nil
+	case t == durationType:
+		return &Schema{AnyOf: []*Schema{{Type: Type{"integer"}}, {Type: Type{"string"}, Pattern: DurationPattern}}}, nil
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		return &Schema{}, nil
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &Schema{Type: Type{"string"}}, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: Type{"boolean"}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: Type{"integer"}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Type{"number"}}, nil
+	case reflect.String:
+		return &Schema{Type: Type{"string"}}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && !reflect.PtrTo(t.Elem()).Implements(textMarshalerType) {
+			return nullable(&Schema{Type: Type{"string"}, ContentEncoding: "base64"}), nil
+		}
+		items, err := r.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Slice {
+			return nullable(&Schema{Type: Type{"array"}, Items: items}), nil
+		}
+		return &Schema{Type: Type{"array"}, Items: items}, nil
+	case reflect.Map:
+		switch t.Key().Kind() {
+		case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		default:
+			if !t.Key().Implements(textMarshalerType) {
+				return nil, fmt.Errorf("jsonschema: unsupported map key type %v", t.Key())
+			}
+		}
+		elem, err := r.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(&Schema{Type: Type{"object"}, AdditionalProperties: elem}), nil
+	case reflect.Struct:
+		return r.ref(t)
+	}
+	return nil, fmt.Errorf("jsonschema: unsupported type %v", t)
+}
+
+// ref returns a reference to the schema of the struct type t, adding it to
+// the definitions on first use. Anonymous structs are inlined.
+func (r *reflector) ref(t reflect.Type) (*Schema, error) {
+	if t == r.root {
+		return &Schema{Ref: "#"}, nil
+	}
+	if t.Name() == "" {
+		return r.structSchema(t)
+	}
+	if name, ok := r.names[t]; ok {
+		return &Schema{Ref: "#/$defs/" + name}, nil
+	}
+	name := t.Name()
+	if _, ok := r.defs[name]; ok {
+		name = strings.ReplaceAll(t.PkgPath(), "/", ".") + "." + name
+	}
+	r.names[t] = name
+	r.defs[name] = nil
+	s, err := r.structSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	r.defs[name] = s
+	return &Schema{Ref: "#/$defs/" + name}, nil
+}
+
+func (r *reflector) structSchema(t reflect.Type) (*Schema, error) {
+	s := &Schema{Type: Type{"object"}, Properties: map[string]*Schema{}}
+	for _, f := range structs.JSONFields(t) {
+		fs, err := r.schema(f.Type)
+		if err != nil {
+			return nil, err
+		}
+		if f.Quoted {
+			fs = quoted(fs)
+		}
+		s.Properties[f.Name] = fs
+		// The fields of a nil embedded pointer are omitted.
+		if f.Type.Kind() != reflect.Ptr && !f.OmitEmpty && !f.Embedded {
+			s.Required = append(s.Required, f.Name)
+		}
+	}
+	return s, nil
+}
+
+// nullable returns s extended to also accept null.
+func nullable(s *Schema) *Schema {
+	switch {
+	case s.Ref != "":
+		return &Schema{AnyOf: []*Schema{s, {Type: Type{"null"}}}}
+	case len(s.Type) == 0 && len(s.AnyOf) == 0:
+		return s
+	case len(s.AnyOf) > 0:
+		for _, a := range s.AnyOf {
+			if len(a.Type) == 1 && a.Type[0] == "null" {
+				return s
+			}
+		}
+		return &Schema{AnyOf: append(s.AnyOf, &Schema{Type: Type{"null"}})}
+	}
+	for _, t := range s.Type {
+		if t == "null" {
+			return s
+		}
+	}
+	n := *s
+	n.Type = append(append(Type{}, s.Type...), "null")
+	return &n
+}
+
+// quoted returns the schema of a field with the ",string" option.
+func quoted(s *Schema) *Schema {
+	n := *s
+	n.Type = nil
+	n.AnyOf = nil
+	for _, a := range s.AnyOf {
+		n.AnyOf = append(n.AnyOf, quoted(a))
+	}
+	for _, t := range s.Type {
+		switch t {
+		case "boolean", "integer", "number":
+			t = "string"
+		}
+		n.Type = append(n.Type, t)
+	}
+	return &n
+}
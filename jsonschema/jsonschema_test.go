@@ -0,0 +1,169 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+type Meta struct {
+	Name   *string           `json:"name,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type Container struct {
+	Image string             `json:"image"`
+	Args  []*string          `json:"args,omitempty"`
+	Env   map[string]*string `json:"env"`
+}
+
+type Spec struct {
+	Meta       `json:",inline"`
+	Replicas   *int32         `json:"replicas,omitempty"`
+	Ratio      *float64       `json:"ratio,omitempty"`
+	Paused     bool           `json:"paused"`
+	Deadline   *time.Time     `json:"deadline,omitempty"`
+	Created    time.Time      `json:"created"`
+	Timeout    *time.Duration `json:"timeout,omitempty"`
+	Containers []Container    `json:"containers"`
+	Sidecar    *Container     `json:"sidecar,omitempty"`
+	Size       *int64         `json:"size,string,omitempty"`
+	Data       []byte         `json:"data,omitempty"`
+	Extra      interface{}    `json:"extra,omitempty"`
+	Internal   *string        `json:"-"`
+	count      int
+}
+
+type Tree struct {
+	Value    *string `json:"value"`
+	Children []*Tree `json:"children,omitempty"`
+	Parent   *Node   `json:"parent,omitempty"`
+}
+
+type Node struct {
+	ID   uint64 `json:"id"`
+	Next *Node  `json:"next"`
+}
+
+var testCasesReflect = []struct {
+	name string
+	v    interface{}
+}{
+	{"spec", &Spec{}},
+	{"tree", Tree{}},
+	{"embedded", struct {
+		*Meta
+		ID string `json:"id"`
+	}{}},
+	{"anonymous", struct {
+		A *struct {
+			B *bool `json:"b"`
+		} `json:"a"`
+		M map[int][]*time.Time `json:"m"`
+	}{}},
+}
+
+func TestReflect(t *testing.T) {
+	for idx, c := range testCasesReflect {
+		s, err := Reflect(c.v)
+		if err != nil {
+			t.Errorf("Unexpected error at idx %d: %v", idx, err)
+			continue
+		}
+		a, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			t.Errorf("Unexpected error at idx %d: %v", idx, err)
+			continue
+		}
+		a = append(a, '\n')
+		golden := filepath.Join("testdata", c.name+".golden")
+		if *update {
+			if err := os.WriteFile(golden, a, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		e, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(e, a) {
+			t.Errorf("Unexpected schema at idx %d, expected\n%s\ngot\n%s", idx, e, a)
+		}
+		var rt Schema
+		if err := json.Unmarshal(a, &rt); err != nil || !reflect.DeepEqual(s, &rt) {
+			t.Errorf("Unexpected round trip at idx %d: %v", idx, err)
+		}
+	}
+}
+
+var testCasesReflectError = []interface{}{
+	nil,
+	"a",
+	(*int)(nil),
+	struct{ C chan int }{},
+	struct{ M map[[2]int]string }{},
+}
+
+func TestReflectError(t *testing.T) {
+	for idx, v := range testCasesReflectError {
+		if _, err := Reflect(v); err == nil {
+			t.Errorf("Expected error at idx %d", idx)
+		}
+	}
+}
+
+var testCasesType = []struct {
+	in       Type
+	expected string
+}{
+	{Type{"string"}, `"string"`},
+	{Type{"string", "null"}, `["string","null"]`},
+}
+
+func TestType(t *testing.T) {
+	for idx, c := range testCasesType {
+		a, err := json.Marshal(c.in)
+		if err != nil || string(a) != c.expected {
+			t.Errorf("Unexpected encoding at idx %d, expected %s, got %s (%v)", idx, c.expected, a, err)
+		}
+		var rt Type
+		if err := json.Unmarshal(a, &rt); err != nil || !reflect.DeepEqual(c.in, rt) {
+			t.Errorf("Unexpected decoding at idx %d, expected %v, got %v (%v)", idx, c.in, rt, err)
+		}
+	}
+}
+
+var testCasesDurationPattern = []struct {
+	in       string
+	expected bool
+}{
+	{"0s", true},
+	{"0", true},
+	{"1h2m3.5s", true},
+	{"-300ms", true},
+	{"1.5µs", true},
+	{"10", false},
+	{"1d", false},
+	{"", false},
+}
+
+func TestDurationPattern(t *testing.T) {
+	re := regexp.MustCompile(DurationPattern)
+	for idx, c := range testCasesDurationPattern {
+		if e, a := c.expected, re.MatchString(c.in); e != a {
+			t.Errorf("Unexpected match at idx %d for %q, expected %v, got %v", idx, c.in, e, a)
+		}
+		if d, err := time.ParseDuration(c.in); err == nil && !re.MatchString(d.String()) {
+			t.Errorf("Unexpected mismatch at idx %d for %q", idx, d)
+		}
+	}
+}
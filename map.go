@@ -0,0 +1,99 @@
+package pointer
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// CompactMap returns a new map holding the entries of src whose values
+// are not nil. Unlike StringMap and friends the pointers are kept.
+func CompactMap[K comparable, T any](src map[K]*T) map[K]*T {
+	dst := make(map[K]*T)
+	for k, val := range src {
+		if val != nil {
+			dst[k] = val
+		}
+	}
+	return dst
+}
+
+// NilKeys returns the sorted keys of src whose values are nil.
+func NilKeys[K cmp.Ordered, T any](src map[K]*T) []K {
+	dst := make([]K, 0)
+	for k, val := range src {
+		if val == nil {
+			dst = append(dst, k)
+		}
+	}
+	slices.Sort(dst)
+	return dst
+}
+
+// Keys returns the sorted keys of src.
+func Keys[K cmp.Ordered, V any](src map[K]V) []K {
+	dst := make([]K, 0, len(src))
+	for k := range src {
+		dst = append(dst, k)
+	}
+	slices.Sort(dst)
+	return dst
+}
+
+// MapDiff describes how a map of pointers changed. Every list of keys is
+// sorted.
+type MapDiff[K cmp.Ordered] struct {
+	// Added holds keys present only in the new map.
+	Added []K
+	// Removed holds keys present only in the old map.
+	Removed []K
+	// Changed holds keys whose value changed, including values that went
+	// from nil to non-nil.
+	Changed []K
+	// BecameNil holds keys whose value went from non-nil to nil.
+	BecameNil []K
+}
+
+// IsEmpty reports whether the diff holds no changes.
+func (d MapDiff[K]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 && len(d.BecameNil) == 0
+}
+
+// DiffMaps compares the old map a with the new map b. Values are compared
+// by the values they point to, not by address.
+func DiffMaps[K cmp.Ordered, T comparable](a, b map[K]*T) MapDiff[K] {
+	return DiffMapsFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// DiffMapsFunc is like DiffMaps but compares values with eq.
+func DiffMapsFunc[K cmp.Ordered, T any](a, b map[K]*T, eq func(x, y T) bool) MapDiff[K] {
+	var d MapDiff[K]
+	for k, av := range a {
+		bv, ok := b[k]
+		switch {
+		case !ok:
+			d.Removed = append(d.Removed, k)
+		case av == nil && bv == nil:
+		case bv == nil:
+			d.BecameNil = append(d.BecameNil, k)
+		case av == nil || !eq(*av, *bv):
+			d.Changed = append(d.Changed, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			d.Added = append(d.Added, k)
+		}
+	}
+	slices.Sort(d.Added)
+	slices.Sort(d.Removed)
+	slices.Sort(d.Changed)
+	slices.Sort(d.BecameNil)
+	return d
+}
+
+// TimeDiffMaps compares two string maps of time.Time pointers using
+// time.Time.Equal.
+func TimeDiffMaps(a, b map[string]*time.Time) MapDiff[string] {
+	return DiffMapsFunc(a, b, time.Time.Equal)
+}
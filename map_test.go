@@ -0,0 +1,69 @@
+package pointer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompactMap(t *testing.T) {
+	a := StringP("1")
+	in := map[string]*string{"a": a, "b": nil}
+	out := CompactMap(in)
+	if e, a := map[string]*string{"a": a}, out; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if out["a"] != a {
+		t.Errorf("Expected pointer to be kept")
+	}
+	if e, a := []string{"b"}, NilKeys(in); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := []string{"a", "b"}, Keys(in); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestDiffMaps(t *testing.T) {
+	a := map[string]*int64{
+		"same":      Int64P(1),
+		"changed":   Int64P(1),
+		"removed":   Int64P(1),
+		"becameNil": Int64P(1),
+		"becameSet": nil,
+		"stillNil":  nil,
+	}
+	b := map[string]*int64{
+		"same":      Int64P(1),
+		"changed":   Int64P(2),
+		"added":     nil,
+		"becameNil": nil,
+		"becameSet": Int64P(0),
+		"stillNil":  nil,
+	}
+	d := DiffMaps(a, b)
+	expected := MapDiff[string]{
+		Added:     []string{"added"},
+		Removed:   []string{"removed"},
+		Changed:   []string{"becameSet", "changed"},
+		BecameNil: []string{"becameNil"},
+	}
+	if !reflect.DeepEqual(expected, d) {
+		t.Errorf("Expected %+v, got %+v", expected, d)
+	}
+	if d.IsEmpty() {
+		t.Errorf("Expected diff to be non-empty")
+	}
+	if d := DiffMaps(a, a); !d.IsEmpty() {
+		t.Errorf("Expected empty diff, got %+v", d)
+	}
+}
+
+func TestTimeDiffMaps(t *testing.T) {
+	now := time.Now()
+	a := TimePMap(map[string]time.Time{"a": now, "b": now})
+	b := TimePMap(map[string]time.Time{"a": now.UTC(), "b": now.Add(time.Second)})
+	if e, a := []string{"b"}, TimeDiffMaps(a, b).Changed; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
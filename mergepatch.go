@@ -0,0 +1,259 @@
+package pointer
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"gomodules.xyz/pointer/internal/structs"
+)
+
+var nullMarkers sync.Map // map[reflect.Type]interface{}
+
+// Null returns a shared marker pointer of type *T. A field set to the
+// marker is emitted as JSON null by MergePatch, which removes the field
+// on the receiving side. The value it points to must not be modified.
+func Null[T any]() *T {
+	t := reflect.TypeOf((*T)(nil))
+	if p, ok := nullMarkers.Load(t); ok {
+		return p.(*T)
+	}
+	p, _ := nullMarkers.LoadOrStore(t, new(T))
+	return p.(*T)
+}
+
+// IsNull reports whether p is the marker returned by Null.
+func IsNull[T any](p *T) bool {
+	return p != nil && p == Null[T]()
+}
+
+func isNullValue(v reflect.Value) bool {
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	p, ok := nullMarkers.Load(v.Type())
+	return ok && reflect.ValueOf(p).Pointer() == v.Pointer()
+}
+
+// MergePatch returns an RFC 7386 JSON merge patch built from the struct v.
+// Nil pointers, nil maps and nil slices are left out of the patch, fields
+// set to a Null marker are emitted as null, and nil values of maps of
+// pointers are emitted as null so that the keys get removed. Nested
+// structs are emitted as nested patches. Field names follow the json
+// struct tags. Like json.Marshal, it returns a *json.UnsupportedValueError
+// if v contains a cycle.
+func MergePatch(v interface{}) ([]byte, error) {
+	visiting := make(map[ptrKey]bool)
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		visiting[keyOf(rv)] = true
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("pointer: MergePatch called with non-struct type %T", v)
+	}
+	patch, err := mergePatchStruct(rv, visiting)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(patch)
+}
+
+// mergePatchStruct returns the patch of the struct v. Pointers in
+// visiting are being patched already; reaching one again is reported
+// like json.Marshal reports cycles.
+func mergePatchStruct(v reflect.Value, visiting map[ptrKey]bool) (map[string]interface{}, error) {
+	dst := make(map[string]interface{})
+	for _, f := range structs.JSONFields(v.Type()) {
+		fv, ok := structs.FieldByIndex(v, f.Index, false)
+		if !ok {
+			continue
+		}
+		if isNullValue(fv) {
+			dst[f.Name] = nil
+			continue
+		}
+		val, ok, err := mergePatchValue(fv, f.OmitEmpty, visiting)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			dst[f.Name] = val
+		}
+	}
+	return dst, nil
+}
+
+func mergePatchValue(v reflect.Value, omitEmpty bool, visiting map[ptrKey]bool) (interface{}, bool, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		if !isStructValue(v.Elem()) {
+			return v.Interface(), true, nil
+		}
+		if visiting[keyOf(v)] {
+			return nil, false, cycleError(v)
+		}
+		visiting[keyOf(v)] = true
+		defer delete(visiting, keyOf(v))
+		val, err := mergePatchStruct(v.Elem(), visiting)
+		return val, err == nil, err
+	case reflect.Struct:
+		if isStructValue(v) {
+			val, err := mergePatchStruct(v, visiting)
+			return val, err == nil, err
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		dst := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k := fmt.Sprint(iter.Key().Interface())
+			if iter.Value().Kind() == reflect.Ptr && iter.Value().IsNil() {
+				dst[k] = nil
+				continue
+			}
+			val, _, err := mergePatchValue(iter.Value(), false, visiting)
+			if err != nil {
+				return nil, false, err
+			}
+			dst[k] = val
+		}
+		return dst, true, nil
+	case reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+	}
+	if omitEmpty && v.IsZero() {
+		return nil, false, nil
+	}
+	return v.Interface(), true, nil
+}
+
+// ApplyMergePatch applies the RFC 7386 JSON merge patch onto the struct
+// pointed to by dst. A null in the patch sets the field to its zero value,
+// which is nil for pointer fields, and removes the key from map fields.
+// Nested objects are merged into nested structs and maps, allocating them
+// if needed; any other value replaces the field.
+func ApplyMergePatch(dst interface{}, patch []byte) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("pointer: ApplyMergePatch called with non-struct pointer type %T", dst)
+	}
+	if !isJSONObject(patch) {
+		return errNotObject
+	}
+	return applyMergePatch(rv.Elem(), patch)
+}
+
+func applyMergePatch(v reflect.Value, patch []byte) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !isStructValue(reflect.Zero(v.Type().Elem())) || !isJSONObject(patch) {
+			break
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return applyMergePatch(v.Elem(), patch)
+	case reflect.Struct:
+		if !isStructValue(v) {
+			break
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(patch, &obj); err != nil {
+			return err
+		}
+		fields := structs.JSONFields(v.Type())
+		for k, raw := range obj {
+			f, ok := structs.Find(fields, k)
+			if !ok {
+				continue
+			}
+			null := isJSONNull(raw)
+			fv, ok := structs.FieldByIndex(v, f.Index, !null)
+			switch {
+			case !ok && null:
+				continue
+			case !ok:
+				return fmt.Errorf("%s: cannot set embedded pointer to unexported struct", f.Name)
+			case null:
+				fv.Set(reflect.Zero(fv.Type()))
+				continue
+			}
+			if err := applyMergePatch(fv, raw); err != nil {
+				return fmt.Errorf("%s: %w", f.Name, err)
+			}
+		}
+		return nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || !isJSONObject(patch) {
+			break
+		}
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(patch, &obj); err != nil {
+			return err
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(v.Type(), len(obj)))
+		}
+		for k, raw := range obj {
+			key := reflect.ValueOf(k).Convert(v.Type().Key())
+			if isJSONNull(raw) {
+				v.SetMapIndex(key, reflect.Value{})
+				continue
+			}
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if old := v.MapIndex(key); old.IsValid() {
+				elem.Set(old)
+			}
+			if err := applyMergePatch(elem, raw); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+			v.SetMapIndex(key, elem)
+		}
+		return nil
+	}
+	dst := reflect.New(v.Type())
+	if err := json.Unmarshal(patch, dst.Interface()); err != nil {
+		return err
+	}
+	v.Set(dst.Elem())
+	return nil
+}
+
+// isStructValue reports whether v is a struct that is encoded as a JSON
+// object field by field.
+func isStructValue(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	t := v.Type()
+	return !t.Implements(jsonMarshalerType) && !reflect.PtrTo(t).Implements(jsonMarshalerType) &&
+		!t.Implements(textMarshalerType) && !reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func isJSONNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+func isJSONObject(raw json.RawMessage) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) > 0 && raw[0] == '{'
+}
+
+var errNotObject = errors.New("pointer: merge patch is not a JSON object")
@@ -0,0 +1,235 @@
+package pointer
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mergePatchTestMeta struct {
+	Name   *string            `json:"name,omitempty"`
+	Labels map[string]*string `json:"labels,omitempty"`
+}
+
+type mergePatchTestSpec struct {
+	Replicas *int32    `json:"replicas,omitempty"`
+	Args     []*string `json:"args,omitempty"`
+	Paused   *bool     `json:"paused,omitempty"`
+}
+
+type mergePatchTestObject struct {
+	mergePatchTestMeta `json:",inline"`
+	Spec               *mergePatchTestSpec `json:"spec,omitempty"`
+	Deadline           *time.Time          `json:"deadline,omitempty"`
+	Ignored            *string             `json:"-"`
+}
+
+var testCasesMergePatch = []struct {
+	in  mergePatchTestObject
+	out string
+}{
+	{
+		in:  mergePatchTestObject{},
+		out: `{}`,
+	},
+	{
+		in: mergePatchTestObject{
+			mergePatchTestMeta: mergePatchTestMeta{
+				Name: StringP("a"),
+			},
+			Ignored: StringP("b"),
+		},
+		out: `{"name":"a"}`,
+	},
+	{
+		in: mergePatchTestObject{
+			mergePatchTestMeta: mergePatchTestMeta{
+				Labels: map[string]*string{"keep": StringP("1"), "drop": nil},
+			},
+			Spec: &mergePatchTestSpec{
+				Replicas: Null[int32](),
+				Args:     StringPSlice([]string{"-v", "-x"}),
+			},
+			Deadline: Null[time.Time](),
+		},
+		out: `{"deadline":null,"labels":{"drop":null,"keep":"1"},"spec":{"args":["-v","-x"],"replicas":null}}`,
+	},
+}
+
+func TestMergePatch(t *testing.T) {
+	for idx, c := range testCasesMergePatch {
+		b, err := MergePatch(&c.in)
+		if err != nil {
+			t.Fatalf("Unexpected error at idx %d: %v", idx, err)
+		}
+		if e, a := c.out, string(b); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %s, got %s", idx, e, a)
+		}
+	}
+	if _, err := MergePatch(StringP("a")); err == nil {
+		t.Errorf("Expected error for non-struct value")
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	dst := mergePatchTestObject{
+		mergePatchTestMeta: mergePatchTestMeta{
+			Name:   StringP("a"),
+			Labels: StringPMap(map[string]string{"keep": "1", "drop": "2"}),
+		},
+		Spec: &mergePatchTestSpec{
+			Replicas: Int32P(3),
+			Paused:   TrueP(),
+		},
+		Deadline: TimeP(time.Now()),
+	}
+	patch := mergePatchTestObject{
+		mergePatchTestMeta: mergePatchTestMeta{
+			Labels: map[string]*string{"drop": nil, "add": StringP("3")},
+		},
+		Spec: &mergePatchTestSpec{
+			Replicas: Null[int32](),
+			Args:     StringPSlice([]string{"-v"}),
+		},
+		Deadline: Null[time.Time](),
+	}
+	b, err := MergePatch(patch)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := ApplyMergePatch(&dst, b); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if e, a := "a", String(dst.Name); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := map[string]string{"keep": "1", "add": "3"}, StringMap(dst.Labels); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if dst.Spec.Replicas != nil || dst.Deadline != nil {
+		t.Errorf("Expected fields to be cleared")
+	}
+	if !Bool(dst.Spec.Paused) {
+		t.Errorf("Expected untouched field to be kept")
+	}
+	if e, a := []string{"-v"}, StringSlice(dst.Spec.Args); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestApplyMergePatchAllocates(t *testing.T) {
+	var dst mergePatchTestObject
+	if err := ApplyMergePatch(&dst, []byte(`{"spec":{"replicas":2},"labels":{"a":"b"}}`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := int32(2), Int32(dst.Spec.Replicas); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "b", String(dst.Labels["a"]); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if err := ApplyMergePatch(&dst, []byte(`[]`)); err == nil {
+		t.Errorf("Expected error for non-object patch")
+	}
+	if err := ApplyMergePatch(&dst, []byte(`{"spec":{"replicas":"x"}}`)); err == nil {
+		t.Errorf("Expected error for invalid value")
+	}
+}
+
+// MergePatchTestMeta is exported so that ApplyMergePatch can allocate it
+// when embedded.
+type MergePatchTestMeta struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type mergePatchTestEmbedded struct {
+	*MergePatchTestMeta
+	Spec *mergePatchTestSpec `json:"spec,omitempty"`
+}
+
+func TestMergePatchEmbeddedPointer(t *testing.T) {
+	in := mergePatchTestEmbedded{MergePatchTestMeta: &MergePatchTestMeta{Name: StringP("a")}}
+	for idx, v := range []interface{}{in, mergePatchTestEmbedded{}} {
+		e, _ := json.Marshal(v)
+		a, err := MergePatch(v)
+		if err != nil || string(e) != string(a) {
+			t.Errorf("Unexpected patch at idx %d, expected %s, got %s (%v)", idx, e, a, err)
+		}
+	}
+
+	var dst mergePatchTestEmbedded
+	if err := ApplyMergePatch(&dst, []byte(`{"name":null}`)); err != nil || dst.MergePatchTestMeta != nil {
+		t.Errorf("Expected null to leave embedded pointer nil, got %v", err)
+	}
+	if err := ApplyMergePatch(&dst, []byte(`{"name":"x"}`)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if dst.MergePatchTestMeta == nil || String(dst.Name) != "x" {
+		t.Errorf("Expected embedded pointer to be allocated, got %+v", dst.MergePatchTestMeta)
+	}
+	if err := ApplyMergePatch(&dst, []byte(`{"name":null}`)); err != nil || dst.Name != nil {
+		t.Errorf("Expected name to be cleared, got %v", err)
+	}
+
+	var hidden struct {
+		*mergePatchTestMeta
+	}
+	if err := ApplyMergePatch(&hidden, []byte(`{"name":"x"}`)); err == nil {
+		t.Errorf("Expected error for unexported embedded pointer")
+	}
+}
+
+type mergePatchTestNode struct {
+	Name *string                        `json:"name,omitempty"`
+	Next *mergePatchTestNode            `json:"next,omitempty"`
+	Refs map[string]*mergePatchTestNode `json:"refs,omitempty"`
+}
+
+func TestMergePatchCycle(t *testing.T) {
+	a := &mergePatchTestNode{Name: StringP("a")}
+	b := &mergePatchTestNode{Name: StringP("b"), Next: a}
+	a.Next = b
+	c := &mergePatchTestNode{}
+	c.Refs = map[string]*mergePatchTestNode{"self": c}
+	for idx, v := range []interface{}{a, *a, c} {
+		_, err := MergePatch(v)
+		var e *json.UnsupportedValueError
+		if !errors.As(err, &e) {
+			t.Errorf("Expected cycle error at idx %d, got %v", idx, err)
+		}
+	}
+
+	shared := &mergePatchTestNode{Name: StringP("s")}
+	in := mergePatchTestNode{Next: shared, Refs: map[string]*mergePatchTestNode{"x": shared}}
+	out, err := MergePatch(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := `{"next":{"name":"s"},"refs":{"x":{"name":"s"}}}`, string(out); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+func TestNull(t *testing.T) {
+	if Null[string]() != Null[string]() {
+		t.Errorf("Expected Null to return a shared marker")
+	}
+	if IsNull(StringP("")) || !IsNull(Null[string]()) || IsNull[string](nil) {
+		t.Errorf("Unexpected IsNull result")
+	}
+	var v struct {
+		A *int `json:"a"`
+	}
+	v.A = Null[int]()
+	b, _ := MergePatch(v)
+	var out map[string]interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if val, ok := out["a"]; !ok || val != nil {
+		t.Errorf("Expected null, got %s", b)
+	}
+}
@@ -0,0 +1,114 @@
+package pointer
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Must returns the value of the pointer passed in. It panics with a message
+// naming the pointer type, and label if given, if the pointer is nil.
+func Must[T any](p *T, label ...string) T {
+	if p == nil {
+		panic(mustMessage(reflect.TypeOf(p), label))
+	}
+	return *p
+}
+
+func mustMessage(t reflect.Type, label []string) string {
+	msg := "pointer: nil " + t.String() + " dereference"
+	if len(label) > 0 {
+		msg += " of " + strings.Join(label, " ")
+	}
+	return msg
+}
+
+// MustString returns the value of the string pointer passed in. It panics if
+// the pointer is nil.
+func MustString(v *string, label ...string) string {
+	return Must(v, label...)
+}
+
+// MustBool returns the value of the bool pointer passed in. It panics if
+// the pointer is nil.
+func MustBool(v *bool, label ...string) bool {
+	return Must(v, label...)
+}
+
+// MustInt returns the value of the int pointer passed in. It panics if
+// the pointer is nil.
+func MustInt(v *int, label ...string) int {
+	return Must(v, label...)
+}
+
+// MustUint returns the value of the uint pointer passed in. It panics if
+// the pointer is nil.
+func MustUint(v *uint, label ...string) uint {
+	return Must(v, label...)
+}
+
+// MustInt8 returns the value of the int8 pointer passed in. It panics if
+// the pointer is nil.
+func MustInt8(v *int8, label ...string) int8 {
+	return Must(v, label...)
+}
+
+// MustInt16 returns the value of the int16 pointer passed in. It panics if
+// the pointer is nil.
+func MustInt16(v *int16, label ...string) int16 {
+	return Must(v, label...)
+}
+
+// MustInt32 returns the value of the int32 pointer passed in. It panics if
+// the pointer is nil.
+func MustInt32(v *int32, label ...string) int32 {
+	return Must(v, label...)
+}
+
+// MustInt64 returns the value of the int64 pointer passed in. It panics if
+// the pointer is nil.
+func MustInt64(v *int64, label ...string) int64 {
+	return Must(v, label...)
+}
+
+// MustUint8 returns the value of the uint8 pointer passed in. It panics if
+// the pointer is nil.
+func MustUint8(v *uint8, label ...string) uint8 {
+	return Must(v, label...)
+}
+
+// MustUint16 returns the value of the uint16 pointer passed in. It panics if
+// the pointer is nil.
+func MustUint16(v *uint16, label ...string) uint16 {
+	return Must(v, label...)
+}
+
+// MustUint32 returns the value of the uint32 pointer passed in. It panics if
+// the pointer is nil.
+func MustUint32(v *uint32, label ...string) uint32 {
+	return Must(v, label...)
+}
+
+// MustUint64 returns the value of the uint64 pointer passed in. It panics if
+// the pointer is nil.
+func MustUint64(v *uint64, label ...string) uint64 {
+	return Must(v, label...)
+}
+
+// MustFloat32 returns the value of the float32 pointer passed in. It panics if
+// the pointer is nil.
+func MustFloat32(v *float32, label ...string) float32 {
+	return Must(v, label...)
+}
+
+// MustFloat64 returns the value of the float64 pointer passed in. It panics if
+// the pointer is nil.
+func MustFloat64(v *float64, label ...string) float64 {
+	return Must(v, label...)
+}
+
+// MustTime returns the value of the time.Time pointer passed in. It panics if
+// the pointer is nil.
+func MustTime(v *time.Time, label ...string) time.Time {
+	return Must(v, label...)
+}
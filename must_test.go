@@ -0,0 +1,42 @@
+package pointer
+
+import (
+	"testing"
+	"time"
+)
+
+func recoverMessage(f func()) (msg interface{}) {
+	defer func() {
+		msg = recover()
+	}()
+	f()
+	return nil
+}
+
+var testCasesMust = []struct {
+	f        func()
+	expected interface{}
+}{
+	{func() { Must[int](nil) }, "pointer: nil *int dereference"},
+	{func() { Must[int](nil, "replicas") }, "pointer: nil *int dereference of replicas"},
+	{func() { Must(IntP(1)) }, nil},
+	{func() { MustString(nil) }, "pointer: nil *string dereference"},
+	{func() { MustInt64(nil, "spec.size") }, "pointer: nil *int64 dereference of spec.size"},
+	{func() { MustTime(nil, "deadline") }, "pointer: nil *time.Time dereference of deadline"},
+	{func() { Must[[]string](nil) }, "pointer: nil *[]string dereference"},
+}
+
+func TestMust(t *testing.T) {
+	for idx, c := range testCasesMust {
+		if e, a := c.expected, recoverMessage(c.f); e != a {
+			t.Errorf("Unexpected panic at idx %d, expected %v, got %v", idx, e, a)
+		}
+	}
+	if e, a := "a", MustString(StringP("a")); e != a {
+		t.Errorf("Unexpected value, expected %q, got %q", e, a)
+	}
+	now := time.Now()
+	if e, a := now, MustTime(&now, "now"); !e.Equal(a) {
+		t.Errorf("Unexpected value, expected %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,194 @@
+package pointer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gomodules.xyz/pointer/internal/structs"
+)
+
+// Chain returns f(p), or nil if p is nil. Nested calls traverse optional
+// fields without nil checks:
+//
+//	user := Chain(Chain(pod, podSecurityContext), runAsUser)
+func Chain[A, B any](p *A, f func(*A) *B) *B {
+	if p != nil {
+		return f(p)
+	}
+	return nil
+}
+
+// Chain2 returns g(f(p)), or nil at the first nil hop.
+func Chain2[A, B, C any](p *A, f func(*A) *B, g func(*B) *C) *C {
+	return Chain(Chain(p, f), g)
+}
+
+// Chain3 returns h(g(f(p))), or nil at the first nil hop.
+func Chain3[A, B, C, D any](p *A, f func(*A) *B, g func(*B) *C, h func(*C) *D) *D {
+	return Chain(Chain2(p, f, g), h)
+}
+
+// Get returns the value of the field at the dot separated path of Go
+// field names below root, e.g. "Spec.Template.Spec.RunAsUser". Pointers
+// and interfaces along the way are followed. If a hop is nil, Get returns
+// the zero value of the field's type, so the result can always be type
+// asserted to the field's type:
+//
+//	v, err := Get(deploy, "Spec.Replicas")
+//	replicas := Int32(v.(*int32))
+//
+// A nil interface hop leaves the type of the field unknown, so Get
+// returns nil for it. An error is returned if the path names an unknown
+// field.
+func Get(root interface{}, path string) (interface{}, error) {
+	v := reflect.ValueOf(root)
+	if !v.IsValid() {
+		return nil, fmt.Errorf("pointer: Get called with nil root")
+	}
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		sv, ok := derefStruct(v)
+		if !ok && sv.Kind() == reflect.Interface {
+			return nil, nil
+		}
+		if !ok {
+			return nil, fmt.Errorf("pointer: %s is not a struct", strings.Join(names[:i], "."))
+		}
+		f, found := findField(sv.Type(), name)
+		if !found {
+			return nil, fmt.Errorf("pointer: %s has no field %s", sv.Type(), strings.Join(names[:i+1], "."))
+		}
+		fv, ok := structs.FieldByIndex(sv, f.Index, false)
+		if !ok {
+			t, err := fieldPathType(f.Type, names[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			return reflect.Zero(t).Interface(), nil
+		}
+		v = fv
+	}
+	return v.Interface(), nil
+}
+
+// GetAs is like Get but asserts the result to type T. A nil result, such
+// as the one for a nil interface hop, is returned as the zero value of T.
+func GetAs[T any](root interface{}, path string) (T, error) {
+	var t T
+	v, err := Get(root, path)
+	if err != nil || v == nil {
+		return t, err
+	}
+	t, ok := v.(T)
+	if !ok {
+		return t, fmt.Errorf("pointer: %s is of type %T, not %T", path, v, t)
+	}
+	return t, nil
+}
+
+// Set assigns value to the field at the dot separated path of Go field
+// names below root, allocating nil pointers along the way. root must be a
+// non-nil pointer. value must be assignable to the field; if the field is
+// a pointer, a value assignable to the pointed-to type is stored through a
+// newly allocated pointer, so
+//
+//	Set(&pod, "Spec.SecurityContext.RunAsUser", int64(1000))
+//
+// works when RunAsUser is an *int64.
+func Set(root interface{}, path string, value interface{}) error {
+	v := reflect.ValueOf(root)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("pointer: Set called with non-pointer or nil root %T", root)
+	}
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return fmt.Errorf("pointer: %s is not a struct", strings.Join(names[:i], "."))
+		}
+		f, found := findField(v.Type(), name)
+		if !found {
+			return fmt.Errorf("pointer: %s has no field %s", v.Type(), strings.Join(names[:i+1], "."))
+		}
+		fv, ok := structs.FieldByIndex(v, f.Index, true)
+		if !ok {
+			return fmt.Errorf("pointer: cannot allocate unexported embedded struct of %s", strings.Join(names[:i+1], "."))
+		}
+		v = fv
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case !val.IsValid():
+		v.Set(reflect.Zero(v.Type()))
+	case val.Type().AssignableTo(v.Type()):
+		v.Set(val)
+	case v.Kind() == reflect.Ptr && val.Type().AssignableTo(v.Type().Elem()):
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(val)
+		v.Set(p)
+	default:
+		return fmt.Errorf("pointer: cannot assign %T to %s of type %s", value, path, v.Type())
+	}
+	return nil
+}
+
+// derefStruct follows pointers and interfaces until it reaches a struct.
+// A nil pointer to a struct and a nil interface are returned as is.
+func derefStruct(v reflect.Value) (reflect.Value, bool) {
+	for {
+		switch v.Kind() {
+		case reflect.Interface:
+			if v.IsNil() {
+				return v, false
+			}
+			v = v.Elem()
+		case reflect.Ptr:
+			if v.IsNil() {
+				return v, structType(v.Type()) != nil
+			}
+			v = v.Elem()
+		case reflect.Struct:
+			return v, true
+		default:
+			return v, false
+		}
+	}
+}
+
+// structType returns the struct type t points to, or nil.
+func structType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		return t
+	}
+	return nil
+}
+
+func findField(t reflect.Type, name string) (reflect.StructField, bool) {
+	if st := structType(t); st != nil {
+		f, ok := st.FieldByName(name)
+		return f, ok && f.IsExported()
+	}
+	return reflect.StructField{}, false
+}
+
+// fieldPathType returns the type of the field at names below type t.
+func fieldPathType(t reflect.Type, names []string) (reflect.Type, error) {
+	for _, name := range names {
+		f, ok := findField(t, name)
+		if !ok {
+			return nil, fmt.Errorf("pointer: %s has no field %s", t, name)
+		}
+		t = f.Type
+	}
+	return t, nil
+}
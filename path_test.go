@@ -0,0 +1,159 @@
+package pointer
+
+import (
+	"testing"
+)
+
+type pathTestSecurityContext struct {
+	RunAsUser *int64
+}
+
+type pathTestPodSpec struct {
+	SecurityContext *pathTestSecurityContext
+}
+
+type pathTestTemplate struct {
+	Spec pathTestPodSpec
+}
+
+type pathTestSpec struct {
+	Replicas *int32
+	Template *pathTestTemplate
+}
+
+// PathTestMeta is exported so that Set can allocate it when embedded.
+type PathTestMeta struct {
+	Name *string
+}
+
+type pathTestEmbedded struct {
+	*PathTestMeta
+	*pathTestSecurityContext
+}
+
+type pathTestObject struct {
+	Spec *pathTestSpec
+	Meta interface{}
+}
+
+func TestChain(t *testing.T) {
+	spec := func(o *pathTestObject) *pathTestSpec { return o.Spec }
+	template := func(s *pathTestSpec) *pathTestTemplate { return s.Template }
+	securityContext := func(t *pathTestTemplate) *pathTestSecurityContext { return t.Spec.SecurityContext }
+	runAsUser := func(c *pathTestSecurityContext) *int64 { return c.RunAsUser }
+
+	var obj *pathTestObject
+	if Chain(obj, spec) != nil {
+		t.Errorf("Expected nil for nil root")
+	}
+	obj = &pathTestObject{Spec: &pathTestSpec{Template: &pathTestTemplate{}}}
+	if Chain3(obj, spec, template, securityContext) != nil {
+		t.Errorf("Expected nil for nil hop")
+	}
+	obj.Spec.Template.Spec.SecurityContext = &pathTestSecurityContext{RunAsUser: Int64P(1000)}
+	if e, a := int64(1000), Int64(Chain(Chain3(obj, spec, template, securityContext), runAsUser)); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := obj.Spec.Template, Chain2(obj, spec, template); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestGet(t *testing.T) {
+	const path = "Spec.Template.Spec.SecurityContext.RunAsUser"
+	obj := &pathTestObject{}
+	v, err := Get(obj, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if p, ok := v.(*int64); !ok || p != nil {
+		t.Errorf("Expected typed nil *int64, got %#v", v)
+	}
+
+	obj.Spec = &pathTestSpec{Template: &pathTestTemplate{Spec: pathTestPodSpec{
+		SecurityContext: &pathTestSecurityContext{RunAsUser: Int64P(1000)},
+	}}}
+	p, err := GetAs[*int64](*obj, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := int64(1000), Int64(p); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	if v, err := Get(obj, "Meta.Replicas"); err != nil || v != nil {
+		t.Errorf("Expected nil through nil interface, got %#v, %v", v, err)
+	}
+	if p, err := GetAs[*int32](obj, "Meta.Replicas"); err != nil || p != nil {
+		t.Errorf("Expected nil *int32 through nil interface, got %v, %v", p, err)
+	}
+
+	obj.Meta = obj.Spec
+	if p, err := GetAs[*int32](obj, "Meta.Replicas"); err != nil || p != nil {
+		t.Errorf("Expected nil *int32, got %v, %v", p, err)
+	}
+
+	for _, path := range []string{"Spec.Unknown", "Spec.Replicas.Value", "Meta.Unknown"} {
+		if _, err := Get(obj, path); err == nil {
+			t.Errorf("Expected error for path %s", path)
+		}
+	}
+	if _, err := GetAs[*string](obj, "Spec.Replicas"); err == nil {
+		t.Errorf("Expected error for wrong type")
+	}
+}
+
+func TestGetEmbedded(t *testing.T) {
+	obj := &pathTestEmbedded{}
+	if p, err := GetAs[*int64](obj, "RunAsUser"); err != nil || p != nil {
+		t.Errorf("Expected nil *int64 through nil embedded pointer, got %v, %v", p, err)
+	}
+	if p, err := GetAs[*string](*obj, "Name"); err != nil || p != nil {
+		t.Errorf("Expected nil *string through nil embedded pointer, got %v, %v", p, err)
+	}
+	if p, err := GetAs[*string]((*pathTestEmbedded)(nil), "Name"); err != nil || p != nil {
+		t.Errorf("Expected nil *string for nil root, got %v, %v", p, err)
+	}
+	obj.pathTestSecurityContext = &pathTestSecurityContext{RunAsUser: Int64P(1)}
+	if p, err := GetAs[*int64](obj, "RunAsUser"); err != nil || Int64(p) != 1 {
+		t.Errorf("Expected 1, got %v, %v", p, err)
+	}
+}
+
+func TestSet(t *testing.T) {
+	obj := &pathTestObject{}
+	if err := Set(obj, "Spec.Template.Spec.SecurityContext.RunAsUser", int64(1000)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := int64(1000), Int64(obj.Spec.Template.Spec.SecurityContext.RunAsUser); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if err := Set(obj, "Spec.Replicas", Int32P(3)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := int32(3), Int32(obj.Spec.Replicas); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if err := Set(obj, "Spec.Replicas", nil); err != nil || obj.Spec.Replicas != nil {
+		t.Errorf("Expected field to be cleared, got %v", err)
+	}
+	if err := Set(obj, "Spec.Replicas", "3"); err == nil {
+		t.Errorf("Expected error for wrong type")
+	}
+	if err := Set(*obj, "Spec.Replicas", int32(3)); err == nil {
+		t.Errorf("Expected error for non-pointer root")
+	}
+}
+
+func TestSetEmbedded(t *testing.T) {
+	obj := &pathTestEmbedded{}
+	if err := Set(obj, "Name", "a"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if obj.PathTestMeta == nil || String(obj.Name) != "a" {
+		t.Errorf("Expected embedded pointer to be allocated, got %+v", obj.PathTestMeta)
+	}
+	if err := Set(obj, "RunAsUser", int64(1000)); err == nil || obj.pathTestSecurityContext != nil {
+		t.Errorf("Expected error for unexported embedded pointer, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package pointer
+
+import "fmt"
+
+// Ptr wraps an optional value of type T. The zero Ptr is unset. Ptr is
+// the size of a pointer, so converting to and from *T with Of and
+// Pointer is free.
+type Ptr[T any] struct {
+	p *T
+}
+
+// Of returns a Ptr wrapping p.
+func Of[T any](p *T) Ptr[T] {
+	return Ptr[T]{p: p}
+}
+
+// Pointer returns the wrapped pointer.
+func (p Ptr[T]) Pointer() *T {
+	return p.p
+}
+
+// Get returns the value or the zero value of T if p is unset.
+func (p Ptr[T]) Get() T {
+	if p.p != nil {
+		return *p.p
+	}
+	var v T
+	return v
+}
+
+// GetOr returns the value or def if p is unset.
+func (p Ptr[T]) GetOr(def T) T {
+	if p.p != nil {
+		return *p.p
+	}
+	return def
+}
+
+// IsSet reports whether p holds a value.
+func (p Ptr[T]) IsSet() bool {
+	return p.p != nil
+}
+
+// Set makes p point to a copy of v.
+func (p *Ptr[T]) Set(v T) {
+	p.p = &v
+}
+
+// Clear unsets p.
+func (p *Ptr[T]) Clear() {
+	p.p = nil
+}
+
+// Map returns a Ptr holding f applied to the value, or an unset Ptr if p
+// is unset.
+func (p Ptr[T]) Map(f func(T) T) Ptr[T] {
+	return MapPtr(p, f)
+}
+
+// MapPtr is like Ptr.Map but may change the type of the value.
+func MapPtr[T, U any](p Ptr[T], f func(T) U) Ptr[U] {
+	if p.p == nil {
+		return Ptr[U]{}
+	}
+	v := f(*p.p)
+	return Ptr[U]{p: &v}
+}
+
+// String returns "<nil>" if p is unset, otherwise the value formatted
+// with fmt.Sprint.
+func (p Ptr[T]) String() string {
+	if p.p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprint(*p.p)
+}
+
+// Format implements fmt.Formatter. An unset Ptr prints as "<nil>", a set
+// one prints its value with the same verb and flags.
+func (p Ptr[T]) Format(f fmt.State, verb rune) {
+	if p.p == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), *p.p)
+}
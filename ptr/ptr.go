@@ -0,0 +1,61 @@
+// Package ptr mirrors k8s.io/utils/ptr, implemented with
+// gomodules.xyz/pointer, so code can switch import paths without touching
+// call sites.
+package ptr
+
+import (
+	"fmt"
+	"reflect"
+
+	"gomodules.xyz/pointer"
+)
+
+// To returns a pointer to the given value.
+func To[T any](v T) *T {
+	return &v
+}
+
+// Deref dereferences ptr and returns the value it points to if not nil,
+// or else returns def.
+func Deref[T any](ptr *T, def T) T {
+	return pointer.Of(ptr).GetOr(def)
+}
+
+// Equal returns true if both arguments are nil or both arguments
+// dereference to the same value.
+func Equal[T comparable](a, b *T) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	if a == nil {
+		return true
+	}
+	return *a == *b
+}
+
+// AllPtrFieldsNil tests whether all pointer fields in a struct are nil.
+// This is useful when, for example, an API struct is handled by plugins
+// which need to distinguish "no plugin accepted this spec" from "this
+// spec is empty".
+//
+// This function is only valid for structs and pointers to structs. Any
+// other type will cause a panic. Passing a typed nil pointer will return
+// true.
+func AllPtrFieldsNil(obj interface{}) bool {
+	v := reflect.ValueOf(obj)
+	if !v.IsValid() {
+		panic(fmt.Sprintf("reflect.ValueOf() produced a non-valid Value for %#v", obj))
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() == reflect.Ptr && !v.Field(i).IsNil() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package ptr
+
+import (
+	"testing"
+)
+
+func TestTo(t *testing.T) {
+	v := 1
+	p := To(v)
+	if p == &v || *p != 1 {
+		t.Errorf("Expected a pointer to a copy of the value")
+	}
+}
+
+func TestDeref(t *testing.T) {
+	if e, a := 1, Deref(To(1), 2); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := 2, Deref(nil, 2); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "", Deref[string](nil, ""); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+var testCasesEqual = []struct {
+	a, b *string
+	out  bool
+}{
+	{a: nil, b: nil, out: true},
+	{a: To("a"), b: nil, out: false},
+	{a: nil, b: To("a"), out: false},
+	{a: To("a"), b: To("a"), out: true},
+	{a: To("a"), b: To("b"), out: false},
+}
+
+func TestEqual(t *testing.T) {
+	for idx, c := range testCasesEqual {
+		if e, a := c.out, Equal(c.a, c.b); e != a {
+			t.Errorf("Unexpected value at idx %d", idx)
+		}
+	}
+}
+
+func TestAllPtrFieldsNil(t *testing.T) {
+	type nested struct {
+		P *int
+	}
+	testCases := []struct {
+		obj interface{}
+		out bool
+	}{
+		{obj: struct{}{}, out: true},
+		{obj: struct{ X int }{X: 1}, out: true},
+		{obj: struct{ X *int }{}, out: true},
+		{obj: struct{ X *int }{X: To(0)}, out: false},
+		{obj: struct{ N nested }{N: nested{P: To(0)}}, out: true},
+		{obj: &struct{ X *int }{}, out: true},
+		{obj: &struct{ X *int }{X: To(0)}, out: false},
+		{obj: (*struct{ X *int })(nil), out: true},
+	}
+	for idx, c := range testCases {
+		if e, a := c.out, AllPtrFieldsNil(c.obj); e != a {
+			t.Errorf("Unexpected value at idx %d", idx)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for nil interface")
+		}
+	}()
+	AllPtrFieldsNil(nil)
+}
@@ -0,0 +1,68 @@
+package pointer
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestPtr(t *testing.T) {
+	var p Ptr[int64]
+	if p.IsSet() || p.Get() != 0 || p.GetOr(3) != 3 || p.Pointer() != nil {
+		t.Errorf("Expected zero Ptr to be unset")
+	}
+	p.Set(5)
+	if !p.IsSet() || p.Get() != 5 || p.GetOr(3) != 5 {
+		t.Errorf("Expected Ptr to hold 5")
+	}
+	if e, a := int64(5), Int64(p.Pointer()); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := int64(10), p.Map(func(v int64) int64 { return v * 2 }).Get(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	p.Clear()
+	if p.IsSet() || p.Map(func(v int64) int64 { return v * 2 }).IsSet() {
+		t.Errorf("Expected Ptr to be unset")
+	}
+
+	src := StringP("a")
+	s := Of(src)
+	if s.Pointer() != src {
+		t.Errorf("Expected Of to keep the pointer")
+	}
+	if e, a := 1, MapPtr(s, func(v string) int { return len(v) }).Get(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if MapPtr(Of[string](nil), strconv.Quote).IsSet() {
+		t.Errorf("Expected MapPtr of unset Ptr to be unset")
+	}
+}
+
+var testCasesPtrFormat = []struct {
+	format string
+	in     interface{}
+	out    string
+}{
+	{format: "%v", in: Ptr[int]{}, out: "<nil>"},
+	{format: "%v", in: Of(IntP(1)), out: "1"},
+	{format: "%s", in: Of(StringP("a")), out: "a"},
+	{format: "%q", in: Of(StringP("a")), out: `"a"`},
+	{format: "%05.1f", in: Of(Float64P(1.25)), out: "001.2"},
+	{format: "%t", in: Of(FalseP()), out: "false"},
+	{format: "%v", in: []Ptr[int]{Of(IntP(1)), {}}, out: "[1 <nil>]"},
+}
+
+func TestPtrFormat(t *testing.T) {
+	for idx, c := range testCasesPtrFormat {
+		if e, a := c.out, fmt.Sprintf(c.format, c.in); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %q, got %q", idx, e, a)
+		}
+	}
+	if e, a := "<nil>", Of[bool](nil).String(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := "true", Of(TrueP()).String(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,506 @@
+package pointer
+
+import "time"
+
+// Compact returns a new slice holding the non-nil pointers of src.
+func Compact[T any](src []*T) []*T {
+	dst := make([]*T, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
+
+// CompactValues converts a slice of pointers into a slice of values,
+// dropping nil pointers instead of replacing them with the zero value.
+func CompactValues[T any](src []*T) []T {
+	dst := make([]T, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil {
+			dst = append(dst, *(src[i]))
+		}
+	}
+	return dst
+}
+
+// IndexOf returns the index of the first pointer in src that points to
+// a value equal to v, or -1 if there is none.
+func IndexOf[T comparable](src []*T, v T) int {
+	return indexOfFunc(src, v, func(a, b T) bool { return a == b })
+}
+
+// Contains reports whether src holds a pointer to a value equal to v.
+func Contains[T comparable](src []*T, v T) bool {
+	return IndexOf(src, v) >= 0
+}
+
+// Dedup returns a new slice holding the pointers of src, keeping only the
+// first pointer to each distinct value. Only the first nil is kept.
+func Dedup[T comparable](src []*T) []*T {
+	dst := make([]*T, 0, len(src))
+	seen := make(map[T]bool)
+	seenNil := false
+	for i := 0; i < len(src); i++ {
+		if src[i] == nil {
+			if !seenNil {
+				seenNil = true
+				dst = append(dst, nil)
+			}
+			continue
+		}
+		if !seen[*(src[i])] {
+			seen[*(src[i])] = true
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
+
+func indexOfFunc[T any](src []*T, v T, eq func(a, b T) bool) int {
+	for i := 0; i < len(src); i++ {
+		if src[i] != nil && eq(*(src[i]), v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func dedupFunc[T any](src []*T, eq func(a, b T) bool) []*T {
+	dst := make([]*T, 0, len(src))
+	seenNil := false
+	for i := 0; i < len(src); i++ {
+		if src[i] == nil {
+			if !seenNil {
+				seenNil = true
+				dst = append(dst, nil)
+			}
+			continue
+		}
+		if indexOfFunc(dst, *(src[i]), eq) < 0 {
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
+
+// StringCompact returns a new slice holding the non-nil string pointers of src.
+func StringCompact(src []*string) []*string {
+	return Compact(src)
+}
+
+// StringCompactValues converts a slice of string pointers into a slice of
+// string values, dropping nil pointers.
+func StringCompactValues(src []*string) []string {
+	return CompactValues(src)
+}
+
+// StringIndexOf returns the index of the first string pointer in src that
+// points to v, or -1 if there is none.
+func StringIndexOf(src []*string, v string) int {
+	return IndexOf(src, v)
+}
+
+// StringContains reports whether src holds a string pointer to v.
+func StringContains(src []*string, v string) bool {
+	return Contains(src, v)
+}
+
+// StringDedup returns a new slice keeping only the first string pointer to
+// each distinct value of src.
+func StringDedup(src []*string) []*string {
+	return Dedup(src)
+}
+
+// BoolCompact returns a new slice holding the non-nil bool pointers of src.
+func BoolCompact(src []*bool) []*bool {
+	return Compact(src)
+}
+
+// BoolCompactValues converts a slice of bool pointers into a slice of
+// bool values, dropping nil pointers.
+func BoolCompactValues(src []*bool) []bool {
+	return CompactValues(src)
+}
+
+// BoolIndexOf returns the index of the first bool pointer in src that
+// points to v, or -1 if there is none.
+func BoolIndexOf(src []*bool, v bool) int {
+	return IndexOf(src, v)
+}
+
+// BoolContains reports whether src holds a bool pointer to v.
+func BoolContains(src []*bool, v bool) bool {
+	return Contains(src, v)
+}
+
+// BoolDedup returns a new slice keeping only the first bool pointer to
+// each distinct value of src.
+func BoolDedup(src []*bool) []*bool {
+	return Dedup(src)
+}
+
+// IntCompact returns a new slice holding the non-nil int pointers of src.
+func IntCompact(src []*int) []*int {
+	return Compact(src)
+}
+
+// IntCompactValues converts a slice of int pointers into a slice of
+// int values, dropping nil pointers.
+func IntCompactValues(src []*int) []int {
+	return CompactValues(src)
+}
+
+// IntIndexOf returns the index of the first int pointer in src that
+// points to v, or -1 if there is none.
+func IntIndexOf(src []*int, v int) int {
+	return IndexOf(src, v)
+}
+
+// IntContains reports whether src holds a int pointer to v.
+func IntContains(src []*int, v int) bool {
+	return Contains(src, v)
+}
+
+// IntDedup returns a new slice keeping only the first int pointer to
+// each distinct value of src.
+func IntDedup(src []*int) []*int {
+	return Dedup(src)
+}
+
+// UintCompact returns a new slice holding the non-nil uint pointers of src.
+func UintCompact(src []*uint) []*uint {
+	return Compact(src)
+}
+
+// UintCompactValues converts a slice of uint pointers into a slice of
+// uint values, dropping nil pointers.
+func UintCompactValues(src []*uint) []uint {
+	return CompactValues(src)
+}
+
+// UintIndexOf returns the index of the first uint pointer in src that
+// points to v, or -1 if there is none.
+func UintIndexOf(src []*uint, v uint) int {
+	return IndexOf(src, v)
+}
+
+// UintContains reports whether src holds a uint pointer to v.
+func UintContains(src []*uint, v uint) bool {
+	return Contains(src, v)
+}
+
+// UintDedup returns a new slice keeping only the first uint pointer to
+// each distinct value of src.
+func UintDedup(src []*uint) []*uint {
+	return Dedup(src)
+}
+
+// Int8Compact returns a new slice holding the non-nil int8 pointers of src.
+func Int8Compact(src []*int8) []*int8 {
+	return Compact(src)
+}
+
+// Int8CompactValues converts a slice of int8 pointers into a slice of
+// int8 values, dropping nil pointers.
+func Int8CompactValues(src []*int8) []int8 {
+	return CompactValues(src)
+}
+
+// Int8IndexOf returns the index of the first int8 pointer in src that
+// points to v, or -1 if there is none.
+func Int8IndexOf(src []*int8, v int8) int {
+	return IndexOf(src, v)
+}
+
+// Int8Contains reports whether src holds a int8 pointer to v.
+func Int8Contains(src []*int8, v int8) bool {
+	return Contains(src, v)
+}
+
+// Int8Dedup returns a new slice keeping only the first int8 pointer to
+// each distinct value of src.
+func Int8Dedup(src []*int8) []*int8 {
+	return Dedup(src)
+}
+
+// Int16Compact returns a new slice holding the non-nil int16 pointers of src.
+func Int16Compact(src []*int16) []*int16 {
+	return Compact(src)
+}
+
+// Int16CompactValues converts a slice of int16 pointers into a slice of
+// int16 values, dropping nil pointers.
+func Int16CompactValues(src []*int16) []int16 {
+	return CompactValues(src)
+}
+
+// Int16IndexOf returns the index of the first int16 pointer in src that
+// points to v, or -1 if there is none.
+func Int16IndexOf(src []*int16, v int16) int {
+	return IndexOf(src, v)
+}
+
+// Int16Contains reports whether src holds a int16 pointer to v.
+func Int16Contains(src []*int16, v int16) bool {
+	return Contains(src, v)
+}
+
+// Int16Dedup returns a new slice keeping only the first int16 pointer to
+// each distinct value of src.
+func Int16Dedup(src []*int16) []*int16 {
+	return Dedup(src)
+}
+
+// Int32Compact returns a new slice holding the non-nil int32 pointers of src.
+func Int32Compact(src []*int32) []*int32 {
+	return Compact(src)
+}
+
+// Int32CompactValues converts a slice of int32 pointers into a slice of
+// int32 values, dropping nil pointers.
+func Int32CompactValues(src []*int32) []int32 {
+	return CompactValues(src)
+}
+
+// Int32IndexOf returns the index of the first int32 pointer in src that
+// points to v, or -1 if there is none.
+func Int32IndexOf(src []*int32, v int32) int {
+	return IndexOf(src, v)
+}
+
+// Int32Contains reports whether src holds a int32 pointer to v.
+func Int32Contains(src []*int32, v int32) bool {
+	return Contains(src, v)
+}
+
+// Int32Dedup returns a new slice keeping only the first int32 pointer to
+// each distinct value of src.
+func Int32Dedup(src []*int32) []*int32 {
+	return Dedup(src)
+}
+
+// Int64Compact returns a new slice holding the non-nil int64 pointers of src.
+func Int64Compact(src []*int64) []*int64 {
+	return Compact(src)
+}
+
+// Int64CompactValues converts a slice of int64 pointers into a slice of
+// int64 values, dropping nil pointers.
+func Int64CompactValues(src []*int64) []int64 {
+	return CompactValues(src)
+}
+
+// Int64IndexOf returns the index of the first int64 pointer in src that
+// points to v, or -1 if there is none.
+func Int64IndexOf(src []*int64, v int64) int {
+	return IndexOf(src, v)
+}
+
+// Int64Contains reports whether src holds a int64 pointer to v.
+func Int64Contains(src []*int64, v int64) bool {
+	return Contains(src, v)
+}
+
+// Int64Dedup returns a new slice keeping only the first int64 pointer to
+// each distinct value of src.
+func Int64Dedup(src []*int64) []*int64 {
+	return Dedup(src)
+}
+
+// Uint8Compact returns a new slice holding the non-nil uint8 pointers of src.
+func Uint8Compact(src []*uint8) []*uint8 {
+	return Compact(src)
+}
+
+// Uint8CompactValues converts a slice of uint8 pointers into a slice of
+// uint8 values, dropping nil pointers.
+func Uint8CompactValues(src []*uint8) []uint8 {
+	return CompactValues(src)
+}
+
+// Uint8IndexOf returns the index of the first uint8 pointer in src that
+// points to v, or -1 if there is none.
+func Uint8IndexOf(src []*uint8, v uint8) int {
+	return IndexOf(src, v)
+}
+
+// Uint8Contains reports whether src holds a uint8 pointer to v.
+func Uint8Contains(src []*uint8, v uint8) bool {
+	return Contains(src, v)
+}
+
+// Uint8Dedup returns a new slice keeping only the first uint8 pointer to
+// each distinct value of src.
+func Uint8Dedup(src []*uint8) []*uint8 {
+	return Dedup(src)
+}
+
+// Uint16Compact returns a new slice holding the non-nil uint16 pointers of src.
+func Uint16Compact(src []*uint16) []*uint16 {
+	return Compact(src)
+}
+
+// Uint16CompactValues converts a slice of uint16 pointers into a slice of
+// uint16 values, dropping nil pointers.
+func Uint16CompactValues(src []*uint16) []uint16 {
+	return CompactValues(src)
+}
+
+// Uint16IndexOf returns the index of the first uint16 pointer in src that
+// points to v, or -1 if there is none.
+func Uint16IndexOf(src []*uint16, v uint16) int {
+	return IndexOf(src, v)
+}
+
+// Uint16Contains reports whether src holds a uint16 pointer to v.
+func Uint16Contains(src []*uint16, v uint16) bool {
+	return Contains(src, v)
+}
+
+// Uint16Dedup returns a new slice keeping only the first uint16 pointer to
+// each distinct value of src.
+func Uint16Dedup(src []*uint16) []*uint16 {
+	return Dedup(src)
+}
+
+// Uint32Compact returns a new slice holding the non-nil uint32 pointers of src.
+func Uint32Compact(src []*uint32) []*uint32 {
+	return Compact(src)
+}
+
+// Uint32CompactValues converts a slice of uint32 pointers into a slice of
+// uint32 values, dropping nil pointers.
+func Uint32CompactValues(src []*uint32) []uint32 {
+	return CompactValues(src)
+}
+
+// Uint32IndexOf returns the index of the first uint32 pointer in src that
+// points to v, or -1 if there is none.
+func Uint32IndexOf(src []*uint32, v uint32) int {
+	return IndexOf(src, v)
+}
+
+// Uint32Contains reports whether src holds a uint32 pointer to v.
+func Uint32Contains(src []*uint32, v uint32) bool {
+	return Contains(src, v)
+}
+
+// Uint32Dedup returns a new slice keeping only the first uint32 pointer to
+// each distinct value of src.
+func Uint32Dedup(src []*uint32) []*uint32 {
+	return Dedup(src)
+}
+
+// Uint64Compact returns a new slice holding the non-nil uint64 pointers of src.
+func Uint64Compact(src []*uint64) []*uint64 {
+	return Compact(src)
+}
+
+// Uint64CompactValues converts a slice of uint64 pointers into a slice of
+// uint64 values, dropping nil pointers.
+func Uint64CompactValues(src []*uint64) []uint64 {
+	return CompactValues(src)
+}
+
+// Uint64IndexOf returns the index of the first uint64 pointer in src that
+// points to v, or -1 if there is none.
+func Uint64IndexOf(src []*uint64, v uint64) int {
+	return IndexOf(src, v)
+}
+
+// Uint64Contains reports whether src holds a uint64 pointer to v.
+func Uint64Contains(src []*uint64, v uint64) bool {
+	return Contains(src, v)
+}
+
+// Uint64Dedup returns a new slice keeping only the first uint64 pointer to
+// each distinct value of src.
+func Uint64Dedup(src []*uint64) []*uint64 {
+	return Dedup(src)
+}
+
+// Float32Compact returns a new slice holding the non-nil float32 pointers of src.
+func Float32Compact(src []*float32) []*float32 {
+	return Compact(src)
+}
+
+// Float32CompactValues converts a slice of float32 pointers into a slice of
+// float32 values, dropping nil pointers.
+func Float32CompactValues(src []*float32) []float32 {
+	return CompactValues(src)
+}
+
+// Float32IndexOf returns the index of the first float32 pointer in src that
+// points to v, or -1 if there is none.
+func Float32IndexOf(src []*float32, v float32) int {
+	return IndexOf(src, v)
+}
+
+// Float32Contains reports whether src holds a float32 pointer to v.
+func Float32Contains(src []*float32, v float32) bool {
+	return Contains(src, v)
+}
+
+// Float32Dedup returns a new slice keeping only the first float32 pointer to
+// each distinct value of src.
+func Float32Dedup(src []*float32) []*float32 {
+	return Dedup(src)
+}
+
+// Float64Compact returns a new slice holding the non-nil float64 pointers of src.
+func Float64Compact(src []*float64) []*float64 {
+	return Compact(src)
+}
+
+// Float64CompactValues converts a slice of float64 pointers into a slice of
+// float64 values, dropping nil pointers.
+func Float64CompactValues(src []*float64) []float64 {
+	return CompactValues(src)
+}
+
+// Float64IndexOf returns the index of the first float64 pointer in src that
+// points to v, or -1 if there is none.
+func Float64IndexOf(src []*float64, v float64) int {
+	return IndexOf(src, v)
+}
+
+// Float64Contains reports whether src holds a float64 pointer to v.
+func Float64Contains(src []*float64, v float64) bool {
+	return Contains(src, v)
+}
+
+// Float64Dedup returns a new slice keeping only the first float64 pointer to
+// each distinct value of src.
+func Float64Dedup(src []*float64) []*float64 {
+	return Dedup(src)
+}
+
+// TimeCompact returns a new slice holding the non-nil time.Time pointers of src.
+func TimeCompact(src []*time.Time) []*time.Time {
+	return Compact(src)
+}
+
+// TimeCompactValues converts a slice of time.Time pointers into a slice of
+// time.Time values, dropping nil pointers.
+func TimeCompactValues(src []*time.Time) []time.Time {
+	return CompactValues(src)
+}
+
+// TimeIndexOf returns the index of the first time.Time pointer in src that
+// points to v, or -1 if there is none. Values are compared with time.Time.Equal.
+func TimeIndexOf(src []*time.Time, v time.Time) int {
+	return indexOfFunc(src, v, time.Time.Equal)
+}
+
+// TimeContains reports whether src holds a time.Time pointer to v. Values are compared with time.Time.Equal.
+func TimeContains(src []*time.Time, v time.Time) bool {
+	return indexOfFunc(src, v, time.Time.Equal) >= 0
+}
+
+// TimeDedup returns a new slice keeping only the first time.Time pointer to
+// each distinct value of src. Values are compared with time.Time.Equal.
+func TimeDedup(src []*time.Time) []*time.Time {
+	return dedupFunc(src, time.Time.Equal)
+}
@@ -0,0 +1,75 @@
+package pointer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testCasesCompactSlice = []struct {
+	in  []*string
+	out []string
+}{
+	{in: nil, out: []string{}},
+	{in: []*string{nil, nil}, out: []string{}},
+	{in: []*string{StringP("a"), nil, StringP(""), nil, StringP("b")}, out: []string{"a", "", "b"}},
+}
+
+func TestStringCompact(t *testing.T) {
+	for idx, c := range testCasesCompactSlice {
+		out := StringCompact(c.in)
+		if e, a := len(c.out), len(out); e != a {
+			t.Errorf("Unexpected len at idx %d", idx)
+		}
+		for i := range out {
+			if out[i] == nil {
+				t.Errorf("Unexpected nil at idx %d", idx)
+			}
+		}
+		if e, a := c.out, StringCompactValues(c.in); !reflect.DeepEqual(e, a) {
+			t.Errorf("Unexpected value at idx %d: expected %v, got %v", idx, e, a)
+		}
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	in := []*int64{nil, Int64P(3), Int64P(0), Int64P(3)}
+	if e, a := 1, Int64IndexOf(in, 3); e != a {
+		t.Errorf("Expected %d, got %d", e, a)
+	}
+	if e, a := 2, Int64IndexOf(in, 0); e != a {
+		t.Errorf("Expected %d, got %d", e, a)
+	}
+	if e, a := -1, Int64IndexOf(in, 4); e != a {
+		t.Errorf("Expected %d, got %d", e, a)
+	}
+	if !Int64Contains(in, 0) || Int64Contains(in, 4) {
+		t.Errorf("Unexpected Contains result")
+	}
+}
+
+func TestDedup(t *testing.T) {
+	a, b := StringP("a"), StringP("b")
+	in := []*string{a, nil, b, StringP("a"), nil, StringP("b")}
+	out := StringDedup(in)
+	if e, a := []*string{a, nil, b}, out; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", StringSlice(e), StringSlice(a))
+	}
+	if out[0] != a || out[2] != b {
+		t.Errorf("Expected first pointers to be kept")
+	}
+}
+
+func TestTimeSliceUtilities(t *testing.T) {
+	now := time.Now()
+	in := []*time.Time{nil, TimeP(now), TimeP(now.UTC()), TimeP(now.Add(time.Second))}
+	if e, a := 1, TimeIndexOf(in, now.UTC()); e != a {
+		t.Errorf("Expected %d, got %d", e, a)
+	}
+	if e, a := 3, len(TimeDedup(in)); e != a {
+		t.Errorf("Expected len %d, got %d", e, a)
+	}
+	if e, a := 3, len(TimeCompactValues(in)); e != a {
+		t.Errorf("Expected len %d, got %d", e, a)
+	}
+}
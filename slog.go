@@ -0,0 +1,319 @@
+package pointer
+
+import (
+	"log/slog"
+	"time"
+)
+
+// The Slog attribute constructors return an empty slog.Attr for nil
+// pointers, which handlers omit. Their Or variants emit a given value
+// instead, e.g. slog.AnyValue(nil) to get null with JSON handlers:
+//
+//	null := slog.AnyValue(nil)
+//	logger.Info("scaled", pointer.SlogInt32Or("replicas", spec.Replicas, null))
+
+// SlogAttr returns a slog.Attr for the value p points to, or an empty
+// slog.Attr if p is nil.
+func SlogAttr[T any](key string, v *T) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Any(key, *v)
+}
+
+// SlogAttrOr is like SlogAttr but returns an attribute with nilValue if p
+// is nil.
+func SlogAttrOr[T any](key string, v *T, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogAttr(key, v)
+}
+
+// SlogString returns a slog.Attr for the string value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogString(key string, v *string) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.StringValue(*v)}
+}
+
+// SlogStringOr is like SlogString but returns an attribute with nilValue if v
+// is nil.
+func SlogStringOr(key string, v *string, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogString(key, v)
+}
+
+// SlogBool returns a slog.Attr for the bool value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogBool(key string, v *bool) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.BoolValue(*v)}
+}
+
+// SlogBoolOr is like SlogBool but returns an attribute with nilValue if v
+// is nil.
+func SlogBoolOr(key string, v *bool, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogBool(key, v)
+}
+
+// SlogInt returns a slog.Attr for the int value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogInt(key string, v *int) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.IntValue(*v)}
+}
+
+// SlogIntOr is like SlogInt but returns an attribute with nilValue if v
+// is nil.
+func SlogIntOr(key string, v *int, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogInt(key, v)
+}
+
+// SlogUint returns a slog.Attr for the uint value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogUint(key string, v *uint) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Uint64Value(uint64(*v))}
+}
+
+// SlogUintOr is like SlogUint but returns an attribute with nilValue if v
+// is nil.
+func SlogUintOr(key string, v *uint, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogUint(key, v)
+}
+
+// SlogInt8 returns a slog.Attr for the int8 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogInt8(key string, v *int8) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Int64Value(int64(*v))}
+}
+
+// SlogInt8Or is like SlogInt8 but returns an attribute with nilValue if v
+// is nil.
+func SlogInt8Or(key string, v *int8, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogInt8(key, v)
+}
+
+// SlogInt16 returns a slog.Attr for the int16 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogInt16(key string, v *int16) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Int64Value(int64(*v))}
+}
+
+// SlogInt16Or is like SlogInt16 but returns an attribute with nilValue if v
+// is nil.
+func SlogInt16Or(key string, v *int16, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogInt16(key, v)
+}
+
+// SlogInt32 returns a slog.Attr for the int32 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogInt32(key string, v *int32) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Int64Value(int64(*v))}
+}
+
+// SlogInt32Or is like SlogInt32 but returns an attribute with nilValue if v
+// is nil.
+func SlogInt32Or(key string, v *int32, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogInt32(key, v)
+}
+
+// SlogInt64 returns a slog.Attr for the int64 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogInt64(key string, v *int64) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Int64Value(*v)}
+}
+
+// SlogInt64Or is like SlogInt64 but returns an attribute with nilValue if v
+// is nil.
+func SlogInt64Or(key string, v *int64, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogInt64(key, v)
+}
+
+// SlogUint8 returns a slog.Attr for the uint8 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogUint8(key string, v *uint8) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Uint64Value(uint64(*v))}
+}
+
+// SlogUint8Or is like SlogUint8 but returns an attribute with nilValue if v
+// is nil.
+func SlogUint8Or(key string, v *uint8, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogUint8(key, v)
+}
+
+// SlogUint16 returns a slog.Attr for the uint16 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogUint16(key string, v *uint16) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Uint64Value(uint64(*v))}
+}
+
+// SlogUint16Or is like SlogUint16 but returns an attribute with nilValue if v
+// is nil.
+func SlogUint16Or(key string, v *uint16, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogUint16(key, v)
+}
+
+// SlogUint32 returns a slog.Attr for the uint32 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogUint32(key string, v *uint32) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Uint64Value(uint64(*v))}
+}
+
+// SlogUint32Or is like SlogUint32 but returns an attribute with nilValue if v
+// is nil.
+func SlogUint32Or(key string, v *uint32, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogUint32(key, v)
+}
+
+// SlogUint64 returns a slog.Attr for the uint64 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogUint64(key string, v *uint64) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Uint64Value(*v)}
+}
+
+// SlogUint64Or is like SlogUint64 but returns an attribute with nilValue if v
+// is nil.
+func SlogUint64Or(key string, v *uint64, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogUint64(key, v)
+}
+
+// SlogFloat32 returns a slog.Attr for the float32 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogFloat32(key string, v *float32) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Float64Value(float64(*v))}
+}
+
+// SlogFloat32Or is like SlogFloat32 but returns an attribute with nilValue if v
+// is nil.
+func SlogFloat32Or(key string, v *float32, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogFloat32(key, v)
+}
+
+// SlogFloat64 returns a slog.Attr for the float64 value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogFloat64(key string, v *float64) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.Float64Value(*v)}
+}
+
+// SlogFloat64Or is like SlogFloat64 but returns an attribute with nilValue if v
+// is nil.
+func SlogFloat64Or(key string, v *float64, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogFloat64(key, v)
+}
+
+// SlogTime returns a slog.Attr for the time.Time value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogTime(key string, v *time.Time) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.TimeValue(*v)}
+}
+
+// SlogTimeOr is like SlogTime but returns an attribute with nilValue if v
+// is nil.
+func SlogTimeOr(key string, v *time.Time, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogTime(key, v)
+}
+
+// SlogDuration returns a slog.Attr for the time.Duration value v points to, or an empty
+// slog.Attr if v is nil.
+func SlogDuration(key string, v *time.Duration) slog.Attr {
+	if v == nil {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: slog.DurationValue(*v)}
+}
+
+// SlogDurationOr is like SlogDuration but returns an attribute with nilValue if v
+// is nil.
+func SlogDurationOr(key string, v *time.Duration, nilValue slog.Value) slog.Attr {
+	if v == nil {
+		return slog.Attr{Key: key, Value: nilValue}
+	}
+	return SlogDuration(key, v)
+}
@@ -0,0 +1,66 @@
+package pointer
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func newSlogTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func TestSlogAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	newSlogTestLogger(&buf).Info("",
+		SlogString("string", StringP("a")),
+		SlogBool("bool", TrueP()),
+		SlogInt("int", IntP(-1)),
+		SlogUint("uint", UintP(1)),
+		SlogInt8("int8", Int8P(-8)),
+		SlogInt16("int16", Int16P(16)),
+		SlogInt32("int32", Int32P(32)),
+		SlogInt64("int64", Int64P(64)),
+		SlogUint8("uint8", Uint8P(8)),
+		SlogUint16("uint16", Uint16P(16)),
+		SlogUint32("uint32", Uint32P(32)),
+		SlogUint64("uint64", Uint64P(64)),
+		SlogFloat32("float32", Float32P(0.5)),
+		SlogFloat64("float64", Float64P(0.25)),
+		SlogTime("since", TimeP(time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC))),
+		SlogDuration("duration", new(time.Duration)),
+		SlogAttr("generic", StringP("g")),
+		SlogString("nil", nil),
+		SlogAttr[int]("nilGeneric", nil),
+	)
+	e := `{"string":"a","bool":true,"int":-1,"uint":1,"int8":-8,"int16":16,"int32":32,"int64":64,` +
+		`"uint8":8,"uint16":16,"uint32":32,"uint64":64,"float32":0.5,"float64":0.25,` +
+		`"since":"2021-03-04T05:06:07Z","duration":0,"generic":"g"}` + "\n"
+	if a := buf.String(); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+func TestSlogOr(t *testing.T) {
+	null := slog.AnyValue(nil)
+	var buf bytes.Buffer
+	newSlogTestLogger(&buf).Info("",
+		SlogInt64Or("a", nil, null),
+		SlogTimeOr("b", nil, slog.StringValue("never")),
+		SlogAttrOr[bool]("c", nil, null),
+		SlogStringOr("d", StringP("x"), null),
+		SlogAttrOr("e", IntP(1), null),
+		SlogInt64("f", nil),
+	)
+	if e, a := `{"a":null,"b":"never","c":null,"d":"x","e":1}`+"\n", buf.String(); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
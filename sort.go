@@ -0,0 +1,109 @@
+package pointer
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// NilOrder selects where nil pointers are placed when ordering.
+type NilOrder int
+
+const (
+	// NilsFirst orders nil pointers before every non-nil pointer.
+	NilsFirst NilOrder = iota
+	// NilsLast orders nil pointers after every non-nil pointer.
+	NilsLast
+)
+
+// Compare compares the values a and b point to like cmp.Compare. Nil
+// pointers are equal to each other and placed according to order.
+func Compare[T cmp.Ordered](a, b *T, order NilOrder) int {
+	return compareFunc(a, b, order, cmp.Compare[T])
+}
+
+// SortSlice sorts the pointers of s in place by the values they point to,
+// with nil pointers placed according to order.
+func SortSlice[T cmp.Ordered](s []*T, order NilOrder) {
+	slices.SortFunc(s, func(a, b *T) int { return Compare(a, b, order) })
+}
+
+// SortStableSlice is like SortSlice but keeps pointers to equal values in
+// their original order.
+func SortStableSlice[T cmp.Ordered](s []*T, order NilOrder) {
+	slices.SortStableFunc(s, func(a, b *T) int { return Compare(a, b, order) })
+}
+
+// Min returns the first pointer of s to the smallest value, ignoring nil
+// pointers. It returns nil if s holds no non-nil pointer.
+func Min[T cmp.Ordered](s []*T) *T {
+	return extremeFunc(s, cmp.Compare[T], -1)
+}
+
+// Max returns the first pointer of s to the largest value, ignoring nil
+// pointers. It returns nil if s holds no non-nil pointer.
+func Max[T cmp.Ordered](s []*T) *T {
+	return extremeFunc(s, cmp.Compare[T], 1)
+}
+
+// TimeCompare compares the time.Time values a and b point to with
+// time.Time.Compare. Nil pointers are equal to each other and placed
+// according to order.
+func TimeCompare(a, b *time.Time, order NilOrder) int {
+	return compareFunc(a, b, order, time.Time.Compare)
+}
+
+// TimeSortSlice sorts the time.Time pointers of s in place, with nil
+// pointers placed according to order.
+func TimeSortSlice(s []*time.Time, order NilOrder) {
+	slices.SortFunc(s, func(a, b *time.Time) int { return TimeCompare(a, b, order) })
+}
+
+// TimeSortStableSlice is like TimeSortSlice but keeps pointers to equal
+// instants in their original order.
+func TimeSortStableSlice(s []*time.Time, order NilOrder) {
+	slices.SortStableFunc(s, func(a, b *time.Time) int { return TimeCompare(a, b, order) })
+}
+
+// TimeMin returns the first pointer of s to the earliest instant,
+// ignoring nil pointers. It returns nil if s holds no non-nil pointer.
+func TimeMin(s []*time.Time) *time.Time {
+	return extremeFunc(s, time.Time.Compare, -1)
+}
+
+// TimeMax returns the first pointer of s to the latest instant, ignoring
+// nil pointers. It returns nil if s holds no non-nil pointer.
+func TimeMax(s []*time.Time) *time.Time {
+	return extremeFunc(s, time.Time.Compare, 1)
+}
+
+func compareFunc[T any](a, b *T, order NilOrder, cmp func(a, b T) int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		if order == NilsLast {
+			return 1
+		}
+		return -1
+	case b == nil:
+		if order == NilsLast {
+			return -1
+		}
+		return 1
+	}
+	return cmp(*a, *b)
+}
+
+// extremeFunc returns the first pointer of s whose value compares with
+// sign against every other value, i.e. the minimum for -1 and the maximum
+// for 1.
+func extremeFunc[T any](s []*T, cmp func(a, b T) int, sign int) *T {
+	var dst *T
+	for i := 0; i < len(s); i++ {
+		if s[i] != nil && (dst == nil || cmp(*(s[i]), *dst)*sign > 0) {
+			dst = s[i]
+		}
+	}
+	return dst
+}
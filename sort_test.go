@@ -0,0 +1,95 @@
+package pointer
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testCasesCompare = []struct {
+	a, b  *int
+	order NilOrder
+	out   int
+}{
+	{a: IntP(1), b: IntP(2), order: NilsFirst, out: -1},
+	{a: IntP(2), b: IntP(1), order: NilsLast, out: 1},
+	{a: IntP(1), b: IntP(1), order: NilsLast, out: 0},
+	{a: nil, b: nil, order: NilsFirst, out: 0},
+	{a: nil, b: IntP(1), order: NilsFirst, out: -1},
+	{a: nil, b: IntP(1), order: NilsLast, out: 1},
+	{a: IntP(1), b: nil, order: NilsFirst, out: 1},
+	{a: IntP(1), b: nil, order: NilsLast, out: -1},
+}
+
+func TestCompare(t *testing.T) {
+	for idx, c := range testCasesCompare {
+		if e, a := c.out, Compare(c.a, c.b, c.order); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %d, got %d", idx, e, a)
+		}
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	s := []*string{StringP("b"), nil, StringP("a"), StringP("c"), nil}
+	SortSlice(s, NilsFirst)
+	if e, a := []string{"", "", "a", "b", "c"}, StringSlice(s); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if s[0] != nil || s[1] != nil {
+		t.Errorf("Expected nils first")
+	}
+	SortSlice(s, NilsLast)
+	if e, a := []string{"a", "b", "c", "", ""}, StringSlice(s); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	one, otherOne := Uint8P(1), Uint8P(1)
+	u := []*uint8{otherOne, nil, Uint8P(0), one}
+	SortStableSlice(u, NilsLast)
+	if u[1] != otherOne || u[2] != one || u[3] != nil {
+		t.Errorf("Expected stable order, got %v", Uint8Slice(u))
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	s := Float64PSlice([]float64{2, -1, 3, -1})
+	s = append(s, nil)
+	if Min(s) != s[1] {
+		t.Errorf("Expected first minimum, got %v", Float64(Min(s)))
+	}
+	if e, a := 3.0, Float64(Max(s)); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if Min([]*int32{nil}) != nil || Max[int64](nil) != nil {
+		t.Errorf("Expected nil for empty slices")
+	}
+	if e, a := math.Inf(-1), Float32(Min([]*float32{Float32P(float32(math.Inf(-1))), Float32P(0)})); float64(a) != e {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
+
+func TestTimeSort(t *testing.T) {
+	now := time.Now()
+	s := []*time.Time{TimeP(now.Add(time.Hour)), nil, TimeP(now), TimeP(now.Add(-time.Hour))}
+	TimeSortSlice(s, NilsLast)
+	if !s[0].Before(*s[1]) || !s[1].Before(*s[2]) || s[3] != nil {
+		t.Errorf("Unexpected order %v", TimeSlice(s))
+	}
+	if e, a := now.Add(-time.Hour), TimeMin(s); !e.Equal(*a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if e, a := now.Add(time.Hour), TimeMax(s); !e.Equal(*a) {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	local, utc := TimeP(now), TimeP(now.UTC())
+	u := []*time.Time{utc, nil, local}
+	TimeSortStableSlice(u, NilsFirst)
+	if u[0] != nil || u[1] != utc || u[2] != local {
+		t.Errorf("Expected stable order for equal instants")
+	}
+	if e, a := 0, TimeCompare(local, utc, NilsFirst); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+}
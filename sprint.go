@@ -0,0 +1,292 @@
+package pointer
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sprint formats v like fmt.Sprintf("%+v", v) but dereferences pointers
+// instead of printing their addresses. Nil pointers print as <nil>, map
+// entries are printed in sorted key order and a pointer that refers back
+// to a value that is being printed prints as <cycle>. Values that
+// implement error or fmt.Stringer are printed with those methods.
+func Sprint(v interface{}) string {
+	var sb strings.Builder
+	p := printer{sb: &sb, visiting: make(map[ptrKey]bool)}
+	p.print(reflect.ValueOf(v))
+	return sb.String()
+}
+
+// Format returns a fmt.Stringer printing v as Sprint does, for use with
+// Printf style functions:
+//
+//	log.Printf("config: %v", pointer.Format(cfg))
+//
+// The result also implements slog.LogValuer like LogValuer.
+func Format(v interface{}) fmt.Stringer {
+	return formatter{v: v}
+}
+
+// LogValuer returns a slog.LogValuer resolving v to a slog.Value with
+// pointers dereferenced. Structs and maps become groups, nil pointers
+// become nil values and slices are rendered as Sprint does.
+func LogValuer(v interface{}) slog.LogValuer {
+	return formatter{v: v}
+}
+
+type formatter struct {
+	v interface{}
+}
+
+func (f formatter) String() string {
+	return Sprint(f.v)
+}
+
+func (f formatter) LogValue() slog.Value {
+	return logValue(reflect.ValueOf(f.v), make(map[ptrKey]bool))
+}
+
+var (
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+	logValuerType = reflect.TypeOf((*slog.LogValuer)(nil)).Elem()
+)
+
+// ptrKey identifies a pointer or map being walked. The type is part of
+// the key, like in encoding/json, because a struct and its first field
+// share an address.
+type ptrKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func keyOf(v reflect.Value) ptrKey {
+	return ptrKey{v.Pointer(), v.Type()}
+}
+
+type printer struct {
+	sb       *strings.Builder
+	visiting map[ptrKey]bool
+}
+
+func (p printer) print(v reflect.Value) {
+	if !v.IsValid() {
+		p.sb.WriteString("<nil>")
+		return
+	}
+	if s, ok := stringMethod(v); ok {
+		p.sb.WriteString(s)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			p.sb.WriteString("<nil>")
+			return
+		}
+		if p.visiting[keyOf(v)] {
+			p.sb.WriteString("<cycle>")
+			return
+		}
+		p.visiting[keyOf(v)] = true
+		p.print(v.Elem())
+		delete(p.visiting, keyOf(v))
+	case reflect.Interface:
+		if v.IsNil() {
+			p.sb.WriteString("<nil>")
+			return
+		}
+		p.print(v.Elem())
+	case reflect.Struct:
+		p.sb.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				p.sb.WriteByte(' ')
+			}
+			p.sb.WriteString(v.Type().Field(i).Name)
+			p.sb.WriteByte(':')
+			p.print(v.Field(i))
+		}
+		p.sb.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			p.sb.WriteString("[]")
+			return
+		}
+		p.sb.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				p.sb.WriteByte(' ')
+			}
+			p.print(v.Index(i))
+		}
+		p.sb.WriteByte(']')
+	case reflect.Map:
+		if v.IsNil() {
+			p.sb.WriteString("map[]")
+			return
+		}
+		if p.visiting[keyOf(v)] {
+			p.sb.WriteString("<cycle>")
+			return
+		}
+		p.visiting[keyOf(v)] = true
+		p.sb.WriteString("map[")
+		for i, k := range sortedMapKeys(v) {
+			if i > 0 {
+				p.sb.WriteByte(' ')
+			}
+			p.print(k)
+			p.sb.WriteByte(':')
+			p.print(v.MapIndex(k))
+		}
+		p.sb.WriteByte(']')
+		delete(p.visiting, keyOf(v))
+	default:
+		p.sb.WriteString(scalarString(v))
+	}
+}
+
+// stringMethod returns the result of the Error or String method of v, if
+// v is not a nil pointer and its method can be called.
+func stringMethod(v reflect.Value) (string, bool) {
+	if !v.CanInterface() || (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return "", false
+	}
+	switch {
+	case v.Type().Implements(errorType):
+		return v.Interface().(error).Error(), true
+	case v.Type().Implements(stringerType):
+		return v.Interface().(fmt.Stringer).String(), true
+	}
+	return "", false
+}
+
+// scalarString formats a value of a basic kind, including values of
+// unexported fields that cannot be passed to fmt.
+func scalarString(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == durationType {
+			return time.Duration(v.Int()).String()
+		}
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return fmt.Sprintf("%s(0x%x)", v.Type(), v.Pointer())
+	}
+	return v.Type().String()
+}
+
+// sortedMapKeys returns the keys of the map v sorted by their printed
+// form.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = Sprint(keyInterface(k))
+	}
+	sort.Sort(mapKeys{keys: keys, names: names})
+	return keys
+}
+
+type mapKeys struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (m mapKeys) Len() int           { return len(m.keys) }
+func (m mapKeys) Less(i, j int) bool { return m.names[i] < m.names[j] }
+func (m mapKeys) Swap(i, j int) {
+	m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	m.names[i], m.names[j] = m.names[j], m.names[i]
+}
+
+func keyInterface(k reflect.Value) interface{} {
+	if k.CanInterface() {
+		return k.Interface()
+	}
+	return scalarString(k)
+}
+
+func logValue(v reflect.Value, visiting map[ptrKey]bool) slog.Value {
+	if !v.IsValid() {
+		return slog.AnyValue(nil)
+	}
+	if v.CanInterface() && v.Type().Implements(logValuerType) && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		return slog.AnyValue(v.Interface())
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		if visiting[keyOf(v)] {
+			return slog.StringValue("<cycle>")
+		}
+		visiting[keyOf(v)] = true
+		defer delete(visiting, keyOf(v))
+		return logValue(v.Elem(), visiting)
+	case reflect.Interface:
+		if v.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		return logValue(v.Elem(), visiting)
+	case reflect.Struct:
+		if v.Type() == timeType && v.CanInterface() {
+			return slog.TimeValue(v.Interface().(time.Time))
+		}
+		if s, ok := stringMethod(v); ok {
+			return slog.StringValue(s)
+		}
+		attrs := make([]slog.Attr, 0, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			attrs = append(attrs, slog.Attr{Key: v.Type().Field(i).Name, Value: logValue(v.Field(i), visiting)})
+		}
+		return slog.GroupValue(attrs...)
+	case reflect.Map:
+		if v.IsNil() {
+			return slog.GroupValue()
+		}
+		if visiting[keyOf(v)] {
+			return slog.StringValue("<cycle>")
+		}
+		visiting[keyOf(v)] = true
+		defer delete(visiting, keyOf(v))
+		keys := sortedMapKeys(v)
+		attrs := make([]slog.Attr, 0, len(keys))
+		for _, k := range keys {
+			attrs = append(attrs, slog.Attr{Key: Sprint(keyInterface(k)), Value: logValue(v.MapIndex(k), visiting)})
+		}
+		return slog.GroupValue(attrs...)
+	case reflect.Slice, reflect.Array:
+		return slog.StringValue(printWith(v, visiting))
+	}
+	if v.CanInterface() {
+		return slog.AnyValue(v.Interface())
+	}
+	return slog.StringValue(scalarString(v))
+}
+
+func printWith(v reflect.Value, visiting map[ptrKey]bool) string {
+	var sb strings.Builder
+	printer{sb: &sb, visiting: visiting}.print(v)
+	return sb.String()
+}
@@ -0,0 +1,146 @@
+package pointer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+type sprintTestNode struct {
+	Name *string
+	Next *sprintTestNode
+}
+
+type sprintTestConfig struct {
+	Name     *string
+	Replicas *int32
+	Timeout  *time.Duration
+	Since    *time.Time
+	Tags     []*string
+	Labels   map[string]*string
+	Err      error
+	hidden   *int
+}
+
+func TestSprint(t *testing.T) {
+	since := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	cfg := sprintTestConfig{
+		Name:    StringP("app"),
+		Timeout: new(time.Duration),
+		Since:   &since,
+		Tags:    []*string{StringP("a"), nil},
+		Labels:  map[string]*string{"z": StringP("1"), "a": nil},
+		Err:     errors.New("boom"),
+		hidden:  IntP(7),
+	}
+	e := "{Name:app Replicas:<nil> Timeout:0s Since:2021-03-04 05:06:07 +0000 UTC Tags:[a <nil>] Labels:map[a:<nil> z:1] Err:boom hidden:7}"
+	if a := Sprint(&cfg); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	if a := fmt.Sprintf("%v", Format(cfg)); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+var testCasesSprint = []struct {
+	in  interface{}
+	out string
+}{
+	{in: nil, out: "<nil>"},
+	{in: (*int)(nil), out: "<nil>"},
+	{in: IntP(1), out: "1"},
+	{in: []*int{IntP(1), nil}, out: "[1 <nil>]"},
+	{in: map[int]*bool{2: FalseP(), 1: TrueP()}, out: "map[1:true 2:false]"},
+	{in: [2]*float64{Float64P(0.5)}, out: "[0.5 <nil>]"},
+	{in: Of(StringP("a")), out: "a"},
+}
+
+func TestSprintValues(t *testing.T) {
+	for idx, c := range testCasesSprint {
+		if e, a := c.out, Sprint(c.in); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %s, got %s", idx, e, a)
+		}
+	}
+}
+
+type sprintTestSelf struct {
+	X int
+	P *int
+}
+
+// logValueString renders v with a text handler, without time, level and
+// message.
+func logValueString(v slog.LogValuer) string {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})).Info("", "v", v)
+	return strings.TrimSpace(strings.ReplaceAll(buf.String(), "v.", ""))
+}
+
+func TestSprintCycle(t *testing.T) {
+	a := &sprintTestNode{Name: StringP("a")}
+	b := &sprintTestNode{Name: StringP("b"), Next: a}
+	a.Next = b
+	if e, a := "{Name:a Next:{Name:b Next:<cycle>}}", Sprint(a); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	// A struct and its first field share an address but are no cycle.
+	var self sprintTestSelf
+	self.X = 5
+	self.P = &self.X
+	if e, a := "{X:5 P:5}", Sprint(&self); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	if e, a := "X=5 P=5", logValueString(LogValuer(&self)); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	shared := StringP("s")
+	pair := []*sprintTestNode{{Name: shared}, {Name: shared}}
+	if e, a := "[{Name:s Next:<nil>} {Name:s Next:<nil>}]", Sprint(pair); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+func TestLogValuer(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	a := &sprintTestNode{Name: StringP("a")}
+	a.Next = a
+	cfg := sprintTestConfig{
+		Name:   StringP("app"),
+		Tags:   []*string{StringP("a"), nil},
+		Labels: map[string]*string{"b": StringP("1"), "a": nil},
+	}
+	logger.Info("loaded", "cfg", LogValuer(&cfg), "node", LogValuer(a))
+	out := buf.String()
+	for _, s := range []string{
+		"cfg.Name=app",
+		"cfg.Replicas=<nil>",
+		`cfg.Tags="[a <nil>]"`,
+		"cfg.Labels.a=<nil> cfg.Labels.b=1",
+		"node.Name=a node.Next=<cycle>",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected output to contain %q, got %s", s, out)
+		}
+	}
+}
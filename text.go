@@ -0,0 +1,275 @@
+package pointer
+
+import (
+	"encoding"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Text wraps a pointer so that it implements encoding.TextMarshaler and
+// encoding.TextUnmarshaler. A nil pointer is rendered as Nil, and text
+// equal to Nil decodes to a nil pointer. Values are rendered with
+// strconv, time.Time as RFC 3339 with nanoseconds, time.Duration with
+// its String method and byte slices as standard base64 like encoding/json
+// does; other types must implement the encoding text interfaces
+// themselves.
+type Text[T any] struct {
+	P *T
+	// Nil is the token a nil pointer is rendered as, "" by default.
+	Nil string
+}
+
+// TextOf returns a Text wrapping p with the empty string as nil token.
+func TextOf[T any](p *T) Text[T] {
+	return Text[T]{P: p}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Text[T]) MarshalText() ([]byte, error) {
+	if t.P == nil {
+		return []byte(t.Nil), nil
+	}
+	s, err := formatText(reflect.ValueOf(t.P).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Text[T]) UnmarshalText(text []byte) error {
+	if string(text) == t.Nil {
+		t.P = nil
+		return nil
+	}
+	p := new(T)
+	if err := parseText(string(text), reflect.ValueOf(p).Elem()); err != nil {
+		return err
+	}
+	t.P = p
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// formatText renders a non-pointer value as text. Like parseText it
+// honors text methods with pointer receivers.
+func formatText(v reflect.Value) (string, error) {
+	if !v.CanAddr() {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p.Elem()
+	}
+	if m, ok := v.Addr().Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String(), nil
+	}
+	if isByteSlice(v.Type()) {
+		return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("pointer: cannot format %s as text", v.Type())
+}
+
+// parseText parses s into the settable non-pointer value v.
+func parseText(s string, v reflect.Value) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	if isByteSlice(v.Type()) {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("pointer: cannot parse text into %s", v.Type())
+	}
+	return nil
+}
+
+// isByteSlice reports whether t is a byte slice without text methods of
+// its own.
+func isByteSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 &&
+		!reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+// EncodeValues encodes the fields of the struct v as query parameters.
+// Parameters are named after the url struct tag, or the field name if
+// there is none; fields tagged "-" are skipped. Nil pointers are skipped,
+// and slices produce one parameter per non-nil element, except byte
+// slices, which are encoded as a single base64 parameter unless nil.
+func EncodeValues(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("pointer: EncodeValues called with non-struct type %T", v)
+	}
+	vals := url.Values{}
+	var errs []error
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, ok := urlFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if isByteSlice(fv.Type()) && fv.IsNil() {
+			continue
+		}
+		elems := []reflect.Value{fv}
+		if fv.Kind() == reflect.Slice && !isByteSlice(fv.Type()) {
+			elems = elems[:0]
+			for j := 0; j < fv.Len(); j++ {
+				elems = append(elems, fv.Index(j))
+			}
+		}
+		for _, ev := range elems {
+			if ev.Kind() == reflect.Ptr {
+				if ev.IsNil() {
+					continue
+				}
+				ev = ev.Elem()
+			}
+			s, err := formatText(ev)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				break
+			}
+			vals.Add(name, s)
+		}
+	}
+	return vals, errors.Join(errs...)
+}
+
+// DecodeValues decodes query parameters into the fields of the struct
+// pointed to by v, using the same names as EncodeValues. Fields without
+// a parameter are left untouched, so pointer fields stay nil. Slices are
+// filled with one element per value.
+func DecodeValues(vals url.Values, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("pointer: DecodeValues called with non-struct pointer type %T", v)
+	}
+	rv = rv.Elem()
+	var errs []error
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, ok := urlFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		ss, ok := vals[name]
+		if !ok || len(ss) == 0 {
+			continue
+		}
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Slice && !isByteSlice(fv.Type()) {
+			dst := reflect.MakeSlice(fv.Type(), len(ss), len(ss))
+			for j, s := range ss {
+				if err := parseTextValue(s, dst.Index(j)); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", name, err))
+					dst = reflect.Value{}
+					break
+				}
+			}
+			if dst.IsValid() {
+				fv.Set(dst)
+			}
+			continue
+		}
+		if err := parseTextValue(ss[0], fv); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// parseTextValue parses s into v, allocating a new value if v is a
+// pointer.
+func parseTextValue(s string, v reflect.Value) error {
+	if v.Kind() != reflect.Ptr {
+		return parseText(s, v)
+	}
+	p := reflect.New(v.Type().Elem())
+	if err := parseText(s, p.Elem()); err != nil {
+		return err
+	}
+	v.Set(p)
+	return nil
+}
+
+func urlFieldName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	tag := f.Tag.Get("url")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
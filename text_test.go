@@ -0,0 +1,198 @@
+package pointer
+
+import (
+	"encoding"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testCasesTextMarshal = []struct {
+	in  encoding.TextMarshaler
+	out string
+}{
+	{in: TextOf[string](nil), out: ""},
+	{in: Text[string]{Nil: "-"}, out: "-"},
+	{in: TextOf(StringP("a")), out: "a"},
+	{in: TextOf(BoolP(true)), out: "true"},
+	{in: TextOf(IntP(-1)), out: "-1"},
+	{in: TextOf(Int8P(-8)), out: "-8"},
+	{in: TextOf(Int16P(16)), out: "16"},
+	{in: TextOf(Int32P(32)), out: "32"},
+	{in: TextOf(Int64P(64)), out: "64"},
+	{in: TextOf(UintP(1)), out: "1"},
+	{in: TextOf(Uint8P(8)), out: "8"},
+	{in: TextOf(Uint16P(16)), out: "16"},
+	{in: TextOf(Uint32P(32)), out: "32"},
+	{in: TextOf(Uint64P(64)), out: "64"},
+	{in: TextOf(Float32P(0.1)), out: "0.1"},
+	{in: TextOf(Float64P(0.25)), out: "0.25"},
+	{in: TextOf(TimeP(time.Date(2021, 3, 4, 5, 6, 7, 8, time.UTC))), out: "2021-03-04T05:06:07.000000008Z"},
+}
+
+func TestTextMarshal(t *testing.T) {
+	for idx, c := range testCasesTextMarshal {
+		b, err := c.in.MarshalText()
+		if err != nil {
+			t.Fatalf("Unexpected error at idx %d: %v", idx, err)
+		}
+		if e, a := c.out, string(b); e != a {
+			t.Errorf("Unexpected value at idx %d: expected %q, got %q", idx, e, a)
+		}
+	}
+}
+
+func TestTextUnmarshal(t *testing.T) {
+	var i Text[int32]
+	if err := i.UnmarshalText([]byte("42")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := int32(42), Int32(i.P); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	if err := i.UnmarshalText(nil); err != nil || i.P != nil {
+		t.Errorf("Expected empty text to decode to nil, got %v, %v", i.P, err)
+	}
+	if err := i.UnmarshalText([]byte("x")); err == nil {
+		t.Errorf("Expected error for invalid text")
+	}
+
+	s := Text[string]{Nil: "<nil>"}
+	if err := s.UnmarshalText(nil); err != nil || String(s.P) != "" || s.P == nil {
+		t.Errorf("Expected empty text to decode to empty string")
+	}
+	if err := s.UnmarshalText([]byte("<nil>")); err != nil || s.P != nil {
+		t.Errorf("Expected nil token to decode to nil")
+	}
+
+	var d Text[time.Duration]
+	if err := d.UnmarshalText([]byte("1m")); err != nil || *d.P != time.Minute {
+		t.Errorf("Expected 1m, got %v, %v", d.P, err)
+	}
+	if b, _ := d.MarshalText(); string(b) != "1m0s" {
+		t.Errorf("Expected 1m0s, got %s", b)
+	}
+
+	var tm Text[time.Time]
+	if err := tm.UnmarshalText([]byte("2021-03-04T05:06:07Z")); err != nil || Time(tm.P).Year() != 2021 {
+		t.Errorf("Unexpected time %v, %v", tm.P, err)
+	}
+
+	c := TextOf(new(complex64))
+	if _, err := c.MarshalText(); err == nil {
+		t.Errorf("Expected error for unsupported type")
+	}
+	if err := c.UnmarshalText([]byte("1")); err == nil {
+		t.Errorf("Expected error for unsupported type")
+	}
+}
+
+type valuesTestQuery struct {
+	Name    *string        `url:"name"`
+	Limit   *int           `url:"limit,omitempty"`
+	Active  *bool          `url:"active"`
+	Timeout *time.Duration `url:"timeout"`
+	Tags    []*string      `url:"tag"`
+	Page    int
+	Skip    *string `url:"-"`
+}
+
+func TestEncodeValues(t *testing.T) {
+	q := valuesTestQuery{
+		Name:    StringP("a b"),
+		Active:  FalseP(),
+		Timeout: new(time.Duration),
+		Tags:    []*string{StringP("x"), nil, StringP("y")},
+		Page:    2,
+		Skip:    StringP("z"),
+	}
+	vals, err := EncodeValues(&q)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := "Page=2&active=false&name=a+b&tag=x&tag=y&timeout=0s", vals.Encode(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+
+	var out valuesTestQuery
+	if err := DecodeValues(vals, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if out.Limit != nil || out.Skip != nil {
+		t.Errorf("Expected missing parameters to stay nil")
+	}
+	q.Tags = StringPSlice([]string{"x", "y"})
+	q.Skip = nil
+	if !reflect.DeepEqual(q, out) {
+		t.Errorf("Expected %+v, got %+v", q, out)
+	}
+}
+
+// textTestLevel implements the text interfaces with pointer receivers.
+type textTestLevel int
+
+func (l *textTestLevel) MarshalText() ([]byte, error) {
+	return []byte(strings.Repeat("+", int(*l))), nil
+}
+
+func (l *textTestLevel) UnmarshalText(text []byte) error {
+	*l = textTestLevel(len(text))
+	return nil
+}
+
+func TestValuesRoundTrip(t *testing.T) {
+	type query struct {
+		Data   []byte           `url:"data"`
+		Empty  []byte           `url:"empty"`
+		Level  textTestLevel    `url:"level"`
+		Levels []*textTestLevel `url:"levels"`
+	}
+	l := textTestLevel(1)
+	q := query{Data: []byte("hi"), Level: 2, Levels: []*textTestLevel{&l}}
+	vals, err := EncodeValues(q)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := "data=aGk%3D&level=%2B%2B&levels=%2B", vals.Encode(); e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	var out query
+	if err := DecodeValues(vals, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(q, out) {
+		t.Errorf("Expected %+v, got %+v", q, out)
+	}
+	if err := DecodeValues(url.Values{"data": {"%"}}, &out); err == nil {
+		t.Errorf("Expected error for invalid base64")
+	}
+
+	b := Text[[]byte]{P: &q.Data}
+	text, err := b.MarshalText()
+	if err != nil || string(text) != "aGk=" {
+		t.Errorf("Unexpected text %q, %v", text, err)
+	}
+	level := TextOf(&l)
+	if text, err := level.MarshalText(); err != nil || string(text) != "+" {
+		t.Errorf("Unexpected text %q, %v", text, err)
+	}
+}
+
+func TestDecodeValuesErrors(t *testing.T) {
+	var out valuesTestQuery
+	err := DecodeValues(url.Values{"limit": {"x"}, "tag": {"a"}, "Page": {"y"}}, &out)
+	if err == nil {
+		t.Fatalf("Expected error")
+	}
+	if e, a := []string{"a"}, StringSlice(out.Tags); !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected valid parameters to be decoded, got %v", a)
+	}
+	if err := DecodeValues(url.Values{}, out); err == nil {
+		t.Errorf("Expected error for non-pointer argument")
+	}
+	if _, err := EncodeValues(StringP("a")); err == nil {
+		t.Errorf("Expected error for non-struct argument")
+	}
+}
@@ -0,0 +1,95 @@
+package pointer
+
+import "fmt"
+
+// The functions below treat a *bool as a tri-state value following
+// Kleene's three-valued logic, where nil means unknown. They never return
+// the shared pointers of TrueP and FalseP, so the results may be modified.
+
+// And returns the Kleene conjunction of a and b: false if either is false,
+// nil if either is nil, and true otherwise.
+func And(a, b *bool) *bool {
+	return All([]*bool{a, b})
+}
+
+// Or returns the Kleene disjunction of a and b: true if either is true,
+// nil if either is nil, and false otherwise.
+func Or(a, b *bool) *bool {
+	return Any([]*bool{a, b})
+}
+
+// Not returns the negation of a, or nil if a is nil.
+func Not(a *bool) *bool {
+	if a == nil {
+		return nil
+	}
+	return BoolP(!*a)
+}
+
+// Xor returns the exclusive disjunction of a and b, or nil if either is
+// nil.
+func Xor(a, b *bool) *bool {
+	if a == nil || b == nil {
+		return nil
+	}
+	return BoolP(*a != *b)
+}
+
+// All returns the Kleene conjunction of src: false if any element is
+// false, nil if any element is nil, and true otherwise, including for an
+// empty slice.
+func All(src []*bool) *bool {
+	return fold(src, false)
+}
+
+// Any returns the Kleene disjunction of src: true if any element is true,
+// nil if any element is nil, and false otherwise, including for an empty
+// slice.
+func Any(src []*bool) *bool {
+	return fold(src, true)
+}
+
+// fold returns dominant if any element of src is dominant, nil if any
+// element is nil, and !dominant otherwise.
+func fold(src []*bool, dominant bool) *bool {
+	unknown := false
+	for _, v := range src {
+		switch {
+		case v == nil:
+			unknown = true
+		case *v == dominant:
+			return BoolP(dominant)
+		}
+	}
+	if unknown {
+		return nil
+	}
+	return BoolP(!dominant)
+}
+
+// BoolString returns "true" or "false" for the bool pointer passed in, or
+// "unset" if the pointer is nil.
+func BoolString(v *bool) string {
+	switch {
+	case v == nil:
+		return "unset"
+	case *v:
+		return "true"
+	}
+	return "false"
+}
+
+// ParseBoolString is the inverse of BoolString. It returns nil for
+// "unset", and an error for anything other than "true", "false" or
+// "unset".
+func ParseBoolString(s string) (*bool, error) {
+	switch s {
+	case "true":
+		return BoolP(true), nil
+	case "false":
+		return BoolP(false), nil
+	case "unset":
+		return nil, nil
+	}
+	return nil, fmt.Errorf("pointer: invalid tri-state bool %q", s)
+}
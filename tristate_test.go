@@ -0,0 +1,136 @@
+package pointer
+
+import (
+	"testing"
+)
+
+// tristates holds unknown, false and true, in that order.
+var tristates = []*bool{nil, BoolP(false), BoolP(true)}
+
+func tristateEqual(a, b *bool) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+// Truth tables indexed by the positions of the operands in tristates.
+var (
+	unk, no, yes = tristates[0], tristates[1], tristates[2]
+
+	truthTableAnd = [3][3]*bool{
+		{unk, no, unk},
+		{no, no, no},
+		{unk, no, yes},
+	}
+	truthTableOr = [3][3]*bool{
+		{unk, unk, yes},
+		{unk, no, yes},
+		{yes, yes, yes},
+	}
+	truthTableXor = [3][3]*bool{
+		{unk, unk, unk},
+		{unk, no, yes},
+		{unk, yes, no},
+	}
+	truthTableNot = [3]*bool{unk, yes, no}
+)
+
+func TestTristateBinary(t *testing.T) {
+	ops := []struct {
+		name  string
+		fn    func(a, b *bool) *bool
+		table [3][3]*bool
+	}{
+		{"And", And, truthTableAnd},
+		{"Or", Or, truthTableOr},
+		{"Xor", Xor, truthTableXor},
+		{"All", func(a, b *bool) *bool { return All([]*bool{a, b}) }, truthTableAnd},
+		{"Any", func(a, b *bool) *bool { return Any([]*bool{a, b}) }, truthTableOr},
+	}
+	for _, op := range ops {
+		for i, a := range tristates {
+			for j, b := range tristates {
+				if e, r := op.table[i][j], op.fn(a, b); !tristateEqual(e, r) {
+					t.Errorf("Unexpected %s(%s, %s), expected %s, got %s", op.name, BoolString(a), BoolString(b), BoolString(e), BoolString(r))
+				}
+			}
+		}
+	}
+}
+
+func TestNot(t *testing.T) {
+	for i, a := range tristates {
+		if e, r := truthTableNot[i], Not(a); !tristateEqual(e, r) {
+			t.Errorf("Unexpected Not(%s), expected %s, got %s", BoolString(a), BoolString(e), BoolString(r))
+		}
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	// Every slice of up to three tri-state values.
+	var slices [][]*bool
+	var gen func(prefix []*bool)
+	gen = func(prefix []*bool) {
+		slices = append(slices, prefix)
+		if len(prefix) == 3 {
+			return
+		}
+		for _, v := range tristates {
+			gen(append(append([]*bool{}, prefix...), v))
+		}
+	}
+	gen(nil)
+	for _, s := range slices {
+		allOf, anyOf := BoolP(true), BoolP(false)
+		for _, v := range s {
+			allOf, anyOf = And(allOf, v), Or(anyOf, v)
+		}
+		if r := All(s); !tristateEqual(allOf, r) {
+			t.Errorf("Unexpected All(%v), expected %s, got %s", boolStrings(s), BoolString(allOf), BoolString(r))
+		}
+		if r := Any(s); !tristateEqual(anyOf, r) {
+			t.Errorf("Unexpected Any(%v), expected %s, got %s", boolStrings(s), BoolString(anyOf), BoolString(r))
+		}
+	}
+}
+
+func boolStrings(s []*bool) []string {
+	out := make([]string, len(s))
+	for i, v := range s {
+		out[i] = BoolString(v)
+	}
+	return out
+}
+
+func TestTristateFresh(t *testing.T) {
+	results := []*bool{And(TrueP(), TrueP()), Or(FalseP(), FalseP()), Not(FalseP()), Xor(TrueP(), FalseP()), All(nil), Any(nil)}
+	for idx, r := range results {
+		if r == TrueP() || r == FalseP() {
+			t.Errorf("Unexpected shared pointer at idx %d", idx)
+		}
+	}
+}
+
+var testCasesBoolString = []struct {
+	in       *bool
+	expected string
+}{
+	{nil, "unset"},
+	{BoolP(false), "false"},
+	{BoolP(true), "true"},
+}
+
+func TestBoolString(t *testing.T) {
+	for idx, c := range testCasesBoolString {
+		if e, a := c.expected, BoolString(c.in); e != a {
+			t.Errorf("Unexpected string at idx %d, expected %q, got %q", idx, e, a)
+		}
+		p, err := ParseBoolString(c.expected)
+		if err != nil || !tristateEqual(c.in, p) {
+			t.Errorf("Unexpected parse at idx %d, expected %s, got %s (%v)", idx, BoolString(c.in), BoolString(p), err)
+		}
+	}
+	for _, s := range []string{"", "True", "1", "nil"} {
+		if _, err := ParseBoolString(s); err == nil {
+			t.Errorf("Expected error parsing %q", s)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package pointer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrNilPointer is matched by the errors returned for nil pointers, e.g.
+// errors.Is(err, ErrNilPointer).
+var ErrNilPointer = errors.New("pointer: nil pointer")
+
+// NilFieldError reports a field that must not be nil.
+type NilFieldError struct {
+	// Field is the path of the field.
+	Field string
+}
+
+func (e *NilFieldError) Error() string {
+	return "pointer: required field " + e.Field + " is nil"
+}
+
+// Unwrap returns ErrNilPointer.
+func (e *NilFieldError) Unwrap() error {
+	return ErrNilPointer
+}
+
+type validateOptions struct {
+	jsonNames           bool
+	requireNonOmitempty bool
+}
+
+// ValidateOption configures ValidateRequired.
+type ValidateOption func(*validateOptions)
+
+// ValidateJSONNames makes ValidateRequired report fields by their json
+// tag names, like the JSONNames option of SetFields.
+func ValidateJSONNames() ValidateOption {
+	return func(o *validateOptions) {
+		o.jsonNames = true
+	}
+}
+
+// RequireNonOmitempty makes ValidateRequired treat every pointer field
+// whose json tag lacks the omitempty option as required.
+func RequireNonOmitempty() ValidateOption {
+	return func(o *validateOptions) {
+		o.requireNonOmitempty = true
+	}
+}
+
+// ValidateRequired reports every nil pointer, slice or map field of the
+// struct v tagged pointer:"required", as a *NilFieldError joined into a
+// single error. Nested structs and non-nil pointers to structs are
+// validated too, except for pointers back to a struct being validated.
+// A nil pointer to a struct is validated like its zero value, so every
+// required field is reported. v must be a struct or a pointer to a
+// struct; any other type, including nil, will cause a panic.
+func ValidateRequired(v interface{}, opts ...ValidateOption) error {
+	var o validateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if v == nil {
+		panic("pointer: ValidateRequired called with nil")
+	}
+	visiting := make(map[ptrKey]bool)
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv = reflect.Zero(rv.Type().Elem())
+			continue
+		}
+		visiting[keyOf(rv)] = true
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("pointer: ValidateRequired called with non-struct type %T", v))
+	}
+	var errs []error
+	o.validateRequired(rv, "", visiting, &errs)
+	return errors.Join(errs...)
+}
+
+// validateRequired appends the errors of the struct v to errs. Pointers
+// in visiting are being validated already and are not followed again.
+func (o validateOptions) validateRequired(v reflect.Value, prefix string, visiting map[ptrKey]bool, errs *[]error) {
+	t := v.Type()
+	for _, f := range (inspectOptions{jsonNames: o.jsonNames}).fields(t) {
+		sf := t.FieldByIndex(f.Index)
+		fv := field(v, f)
+		path := prefix + f.Name
+		switch fv.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map:
+			if fv.IsNil() {
+				if o.isRequired(sf) {
+					*errs = append(*errs, &NilFieldError{Field: path})
+				}
+				continue
+			}
+			if fv.Kind() == reflect.Ptr && isInspectableStruct(fv.Type().Elem()) && !visiting[keyOf(fv)] {
+				visiting[keyOf(fv)] = true
+				o.validateRequired(fv.Elem(), path+".", visiting, errs)
+				delete(visiting, keyOf(fv))
+			}
+		case reflect.Struct:
+			if isInspectableStruct(fv.Type()) {
+				o.validateRequired(fv, path+".", visiting, errs)
+			}
+		}
+	}
+}
+
+func (o validateOptions) isRequired(f reflect.StructField) bool {
+	for _, opt := range strings.Split(f.Tag.Get("pointer"), ",") {
+		if opt == "required" {
+			return true
+		}
+	}
+	if o.requireNonOmitempty && f.Type.Kind() == reflect.Ptr {
+		tag, ok := f.Tag.Lookup("json")
+		if tag == "-" {
+			return false
+		}
+		_, opts, _ := strings.Cut(tag, ",")
+		return !ok || !strings.Contains(","+opts+",", ",omitempty,")
+	}
+	return false
+}
+
+// StringE returns the value of the string pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func StringE(v *string, field string) (string, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero string
+	return zero, &NilFieldError{Field: field}
+}
+
+// BoolE returns the value of the bool pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func BoolE(v *bool, field string) (bool, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero bool
+	return zero, &NilFieldError{Field: field}
+}
+
+// IntE returns the value of the int pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func IntE(v *int, field string) (int, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero int
+	return zero, &NilFieldError{Field: field}
+}
+
+// UintE returns the value of the uint pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func UintE(v *uint, field string) (uint, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero uint
+	return zero, &NilFieldError{Field: field}
+}
+
+// Int8E returns the value of the int8 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Int8E(v *int8, field string) (int8, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero int8
+	return zero, &NilFieldError{Field: field}
+}
+
+// Int16E returns the value of the int16 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Int16E(v *int16, field string) (int16, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero int16
+	return zero, &NilFieldError{Field: field}
+}
+
+// Int32E returns the value of the int32 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Int32E(v *int32, field string) (int32, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero int32
+	return zero, &NilFieldError{Field: field}
+}
+
+// Int64E returns the value of the int64 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Int64E(v *int64, field string) (int64, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero int64
+	return zero, &NilFieldError{Field: field}
+}
+
+// Uint8E returns the value of the uint8 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Uint8E(v *uint8, field string) (uint8, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero uint8
+	return zero, &NilFieldError{Field: field}
+}
+
+// Uint16E returns the value of the uint16 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Uint16E(v *uint16, field string) (uint16, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero uint16
+	return zero, &NilFieldError{Field: field}
+}
+
+// Uint32E returns the value of the uint32 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Uint32E(v *uint32, field string) (uint32, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero uint32
+	return zero, &NilFieldError{Field: field}
+}
+
+// Uint64E returns the value of the uint64 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Uint64E(v *uint64, field string) (uint64, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero uint64
+	return zero, &NilFieldError{Field: field}
+}
+
+// Float32E returns the value of the float32 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Float32E(v *float32, field string) (float32, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero float32
+	return zero, &NilFieldError{Field: field}
+}
+
+// Float64E returns the value of the float64 pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func Float64E(v *float64, field string) (float64, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero float64
+	return zero, &NilFieldError{Field: field}
+}
+
+// TimeE returns the value of the time.Time pointer passed in or a
+// *NilFieldError for field if the pointer is nil.
+func TimeE(v *time.Time, field string) (time.Time, error) {
+	if v != nil {
+		return *v, nil
+	}
+	var zero time.Time
+	return zero, &NilFieldError{Field: field}
+}
@@ -0,0 +1,176 @@
+package pointer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type validateTestSpec struct {
+	Replicas *int32 `json:"replicas" pointer:"required"`
+	Image    *string
+}
+
+type validateTestObject struct {
+	Name     *string           `json:"name" pointer:"required"`
+	Labels   map[string]string `json:"labels,omitempty" pointer:"required"`
+	Spec     *validateTestSpec `json:"spec"`
+	Status   validateTestSpec  `json:"status"`
+	Note     *string           `json:"note,omitempty"`
+	Internal *string           `json:"-"`
+}
+
+func nilFields(err error) []string {
+	var fields []string
+	if err == nil {
+		return fields
+	}
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var fe *NilFieldError
+		if !errors.As(err, &fe) {
+			return nil
+		}
+		fields = append(fields, fe.Field)
+	}
+	return fields
+}
+
+func TestValidateRequired(t *testing.T) {
+	var o validateTestObject
+	err := ValidateRequired(&o)
+	if !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Expected error to match ErrNilPointer, got %v", err)
+	}
+	e := []string{"Name", "Labels", "Status.Replicas"}
+	if a := nilFields(err); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+
+	o.Spec = &validateTestSpec{}
+	e = []string{"name", "labels", "spec.replicas", "status.replicas"}
+	if a := nilFields(ValidateRequired(o, ValidateJSONNames())); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+
+	e = []string{"name", "labels", "spec.replicas", "spec.Image", "status.replicas", "status.Image"}
+	if a := nilFields(ValidateRequired(o, ValidateJSONNames(), RequireNonOmitempty())); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields with RequireNonOmitempty, expected %v, got %v", e, a)
+	}
+	o.Spec = nil
+	e = []string{"name", "labels", "spec", "status.replicas", "status.Image"}
+	if a := nilFields(ValidateRequired(o, ValidateJSONNames(), RequireNonOmitempty())); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields with RequireNonOmitempty, expected %v, got %v", e, a)
+	}
+
+	o = validateTestObject{
+		Name:   StringP("a"),
+		Labels: map[string]string{},
+		Spec:   &validateTestSpec{Replicas: Int32P(1)},
+		Status: validateTestSpec{Replicas: Int32P(0)},
+	}
+	if err := ValidateRequired(o); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestValidateRequiredNilStruct(t *testing.T) {
+	e := []string{"Name", "Labels", "Status.Replicas"}
+	if a := nilFields(ValidateRequired((*validateTestObject)(nil))); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+}
+
+var testCasesValidateRequiredPanics = []struct {
+	in       interface{}
+	expected string
+}{
+	{nil, "pointer: ValidateRequired called with nil"},
+	{StringP("a"), "pointer: ValidateRequired called with non-struct type *string"},
+	{(*string)(nil), "pointer: ValidateRequired called with non-struct type *string"},
+	{1, "pointer: ValidateRequired called with non-struct type int"},
+}
+
+func TestValidateRequiredPanics(t *testing.T) {
+	for idx, c := range testCasesValidateRequiredPanics {
+		if e, a := c.expected, recoverMessage(func() { ValidateRequired(c.in) }); e != a {
+			t.Errorf("Unexpected panic at idx %d, expected %v, got %v", idx, e, a)
+		}
+	}
+}
+
+func TestNilFieldError(t *testing.T) {
+	err := &NilFieldError{Field: "spec.replicas"}
+	if e, a := "pointer: required field spec.replicas is nil", err.Error(); e != a {
+		t.Errorf("Unexpected error message, expected %q, got %q", e, a)
+	}
+}
+
+func TestStringE(t *testing.T) {
+	if v, err := StringE(StringP("a"), "name"); v != "a" || err != nil {
+		t.Errorf("Unexpected result %q, %v", v, err)
+	}
+	v, err := StringE(nil, "name")
+	if v != "" || !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Unexpected result %q, %v", v, err)
+	}
+	var fe *NilFieldError
+	if !errors.As(err, &fe) || fe.Field != "name" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestInt64E(t *testing.T) {
+	if v, err := Int64E(Int64P(-1), "n"); v != -1 || err != nil {
+		t.Errorf("Unexpected result %d, %v", v, err)
+	}
+	if v, err := Int64E(nil, "n"); v != 0 || !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Unexpected result %d, %v", v, err)
+	}
+}
+
+func TestTimeE(t *testing.T) {
+	now := time.Now()
+	if v, err := TimeE(&now, "t"); !v.Equal(now) || err != nil {
+		t.Errorf("Unexpected result %v, %v", v, err)
+	}
+	if v, err := TimeE(nil, "t"); !v.IsZero() || !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Unexpected result %v, %v", v, err)
+	}
+}
+
+func TestValidateRequiredEmbeddedPointer(t *testing.T) {
+	v := struct {
+		*validateTestSpec
+		ID *string `pointer:"required"`
+	}{}
+	e := []string{"Replicas", "ID"}
+	if a := nilFields(ValidateRequired(v)); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+	v.validateTestSpec = &validateTestSpec{Replicas: Int32P(1)}
+	e = []string{"ID"}
+	if a := nilFields(ValidateRequired(v)); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+}
+
+type validateTestNode struct {
+	Name *string           `pointer:"required"`
+	Next *validateTestNode `pointer:"required"`
+}
+
+func TestValidateRequiredCycle(t *testing.T) {
+	n := &validateTestNode{}
+	n.Next = n
+	e := []string{"Name"}
+	if a := nilFields(ValidateRequired(n)); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+	m := &validateTestNode{Name: StringP("m"), Next: n}
+	n.Next = m
+	e = []string{"Next.Name"}
+	if a := nilFields(ValidateRequired(*m)); !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected nil fields, expected %v, got %v", e, a)
+	}
+}